@@ -0,0 +1,182 @@
+package bigo
+
+import (
+	"math"
+	"slices"
+)
+
+// Class is a complexity class.
+type Class int
+
+const (
+	Constant     Class = iota // O(1)
+	Logarithmic               // O(log N)
+	Linear                    // O(N)
+	Linearithmic              // O(N log N)
+	Quadratic                 // O(N²)
+	Exponential               // O(2ⁿ)
+)
+
+// Classes lists every class, from the slowest growing to the fastest.
+var Classes = []Class{Constant, Logarithmic, Linear, Linearithmic, Quadratic, Exponential}
+
+func (c Class) String() string {
+	switch c {
+	case Constant:
+		return "O(1)"
+	case Logarithmic:
+		return "O(log N)"
+	case Linear:
+		return "O(N)"
+	case Linearithmic:
+		return "O(N log N)"
+	case Quadratic:
+		return "O(N²)"
+	case Exponential:
+		return "O(2ⁿ)"
+	}
+
+	return "O(?)"
+}
+
+// growth returns the class's growth function at n.
+func (c Class) growth(n float64) float64 {
+	switch c {
+	case Logarithmic:
+		return math.Log2(n)
+	case Linear:
+		return n
+	case Linearithmic:
+		return n * math.Log2(n)
+	case Quadratic:
+		return n * n
+	case Exponential:
+		return math.Exp2(n)
+	}
+
+	return 1
+}
+
+// Fit is the least-squares fit of measurements y to a + b·f(N) for the
+// growth function f of Class.
+type Fit struct {
+	Class     Class
+	Intercept float64
+	Slope     float64
+	// R2 is the coefficient of determination: 1 is a perfect fit.
+	R2 float64
+	// BIC is the Bayesian information criterion of the fit; lower is
+	// better. It penalises the extra parameter of the growing classes, so
+	// flat but noisy data is still classified as O(1).
+	BIC float64
+	// Weight is the probability that this is the best model among those
+	// that could be fitted, derived from the BIC differences.
+	Weight float64
+}
+
+// FitAll fits y measured at sizes n against every class and returns the
+// fits from best to worst. Classes whose growth overflows at the given
+// sizes, or that only fit with a decreasing slope, are left out.
+func FitAll(n []int, y []float64) []Fit {
+	// nothing grows: no other model can be told apart from O(1)
+	if len(y) > 0 && !slices.ContainsFunc(y, func(v float64) bool { return v != y[0] }) {
+		return []Fit{{Class: Constant, Intercept: y[0], R2: 1, Weight: 1}}
+	}
+
+	fits := []Fit{}
+	for _, c := range Classes {
+		if f, ok := fitClass(c, n, y); ok {
+			fits = append(fits, f)
+		}
+	}
+
+	slices.SortStableFunc(fits, func(a, b Fit) int {
+		switch {
+		case a.BIC < b.BIC:
+			return -1
+		case a.BIC > b.BIC:
+			return 1
+		}
+		return 0
+	})
+
+	// Schwarz weights: exp(-ΔBIC/2), normalised
+	total := 0.0
+	for i := range fits {
+		fits[i].Weight = math.Exp(-(fits[i].BIC - fits[0].BIC) / 2)
+		total += fits[i].Weight
+	}
+	for i := range fits {
+		fits[i].Weight /= total
+	}
+
+	return fits
+}
+
+func fitClass(c Class, n []int, y []float64) (Fit, bool) {
+	count := float64(len(y))
+	if len(y) < 3 {
+		return Fit{}, false
+	}
+
+	meanY := 0.0
+	for _, v := range y {
+		meanY += v
+	}
+	meanY /= count
+
+	totalSquares := 0.0
+	for _, v := range y {
+		totalSquares += (v - meanY) * (v - meanY)
+	}
+
+	fit := Fit{Class: c}
+	residualSquares := totalSquares
+	parameters := 1.0
+
+	if c == Constant {
+		fit.Intercept = meanY
+	} else {
+		x := make([]float64, len(n))
+		meanX := 0.0
+		for i, size := range n {
+			x[i] = c.growth(float64(size))
+			if math.IsInf(x[i], 0) || math.IsNaN(x[i]) {
+				return Fit{}, false
+			}
+			meanX += x[i]
+		}
+		meanX /= count
+
+		sxx, sxy := 0.0, 0.0
+		for i := range x {
+			sxx += (x[i] - meanX) * (x[i] - meanX)
+			sxy += (x[i] - meanX) * (y[i] - meanY)
+		}
+		if sxx == 0 || sxy < 0 {
+			return Fit{}, false
+		}
+
+		fit.Slope = sxy / sxx
+		fit.Intercept = meanY - fit.Slope*meanX
+
+		residualSquares = 0
+		for i := range x {
+			r := y[i] - (fit.Intercept + fit.Slope*x[i])
+			residualSquares += r * r
+		}
+		parameters = 2
+	}
+
+	if totalSquares > 0 {
+		fit.R2 = 1 - residualSquares/totalSquares
+	} else {
+		fit.R2 = 1
+	}
+
+	// a perfect fit would take the log of zero; the floor keeps exact
+	// step counts comparable while still ranking them first
+	variance := max(residualSquares/count, 1e-12*(meanY*meanY+1))
+	fit.BIC = count*math.Log(variance) + parameters*math.Log(count)
+	return fit, true
+}
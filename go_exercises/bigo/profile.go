@@ -0,0 +1,190 @@
+// Package bigo measures how a function's cost grows with its input size and
+// fits the measurements against the usual Big O classes, to check
+// empirically that an algorithm has the complexity we claim for it.
+package bigo
+
+import (
+	"encoding/csv"
+	"fmt"
+	"io"
+	"runtime"
+	"strconv"
+	"text/tabwriter"
+	"time"
+
+	"github.com/devluxor/common-sense-guide-to-dsa/go_exercises/steps"
+)
+
+// DefaultSizes are the input sizes used when Options.Sizes is empty.
+var DefaultSizes = []int{1 << 8, 1 << 9, 1 << 10, 1 << 11, 1 << 12, 1 << 13, 1 << 14, 1 << 15}
+
+// DefaultRepeats is the number of runs per size used when Options.Repeats
+// is not positive.
+const DefaultRepeats = 5
+
+// Options configures Profile.
+type Options struct {
+	// Sizes are the input sizes to measure, at least three of them.
+	Sizes []int
+	// Repeats is the number of runs per size. The fastest run is kept,
+	// since noise only ever makes a run slower.
+	Repeats int
+}
+
+// Sample holds the measurements for one input size.
+type Sample struct {
+	N        int
+	Duration time.Duration
+	Allocs   uint64
+	Bytes    uint64
+	Steps    int
+}
+
+// Result holds the samples of a profile and the fits of every measured
+// quantity, each sorted from best to worst. Steps is empty when the
+// function reported no steps.
+type Result struct {
+	Name    string
+	Samples []Sample
+	Time    []Fit
+	Allocs  []Fit
+	Steps   []Fit
+}
+
+// Best returns the best fitting class for fits and its confidence, the
+// probability in [0, 1] that it is the right model among those fitted.
+func Best(fits []Fit) (Class, float64, bool) {
+	if len(fits) == 0 {
+		return 0, 0, false
+	}
+
+	return fits[0].Class, fits[0].Weight, true
+}
+
+// Profile runs f over inputs built by generate for every size in opts and
+// fits the time, allocations and steps it took. The input is generated
+// afresh for every run, outside the measurement, so f may modify it. f
+// should report its steps to the counter it is given, typically by calling
+// a Counted variant.
+func Profile[In any](name string, generate func(n int) In, f func(in In, c *steps.Counter), opts Options) (Result, error) {
+	sizes := opts.Sizes
+	if len(sizes) == 0 {
+		sizes = DefaultSizes
+	}
+	if len(sizes) < 3 {
+		return Result{}, fmt.Errorf("bigo: need at least 3 sizes, got %d", len(sizes))
+	}
+	repeats := opts.Repeats
+	if repeats <= 0 {
+		repeats = DefaultRepeats
+	}
+
+	result := Result{Name: name}
+	for _, n := range sizes {
+		if n < 1 {
+			return Result{}, fmt.Errorf("bigo: invalid size %d", n)
+		}
+		result.Samples = append(result.Samples, measure(n, repeats, generate, f))
+	}
+
+	ns := make([]int, len(sizes))
+	durations := make([]float64, len(sizes))
+	allocs := make([]float64, len(sizes))
+	counts := make([]float64, len(sizes))
+	counted := false
+	for i, s := range result.Samples {
+		ns[i] = s.N
+		durations[i] = float64(s.Duration)
+		allocs[i] = float64(s.Allocs)
+		counts[i] = float64(s.Steps)
+		counted = counted || s.Steps > 0
+	}
+
+	result.Time = FitAll(ns, durations)
+	result.Allocs = FitAll(ns, allocs)
+	if counted {
+		result.Steps = FitAll(ns, counts)
+	}
+
+	return result, nil
+}
+
+func measure[In any](n, repeats int, generate func(n int) In, f func(in In, c *steps.Counter)) Sample {
+	sample := Sample{N: n}
+	var before, after runtime.MemStats
+
+	for i := range repeats {
+		in := generate(n)
+		c := &steps.Counter{}
+
+		runtime.GC()
+		runtime.ReadMemStats(&before)
+		start := time.Now()
+		f(in, c)
+		elapsed := time.Since(start)
+		runtime.ReadMemStats(&after)
+
+		if i == 0 || elapsed < sample.Duration {
+			sample.Duration = elapsed
+		}
+		// allocations and steps are deterministic for most functions;
+		// keep the smallest in case the runtime allocated on the side
+		allocs, bytes := after.Mallocs-before.Mallocs, after.TotalAlloc-before.TotalAlloc
+		if i == 0 || allocs < sample.Allocs {
+			sample.Allocs, sample.Bytes = allocs, bytes
+		}
+		if i == 0 || c.Total() < sample.Steps {
+			sample.Steps = c.Total()
+		}
+	}
+
+	return sample
+}
+
+// WriteTable writes the samples and the best fit of every quantity as an
+// aligned, human readable table.
+func (r Result) WriteTable(w io.Writer) error {
+	tw := tabwriter.NewWriter(w, 0, 0, 2, ' ', tabwriter.AlignRight)
+	fmt.Fprintf(tw, "N\ttime\tallocs\tbytes\tsteps\t\n")
+	for _, s := range r.Samples {
+		fmt.Fprintf(tw, "%d\t%v\t%d\t%d\t%d\t\n", s.N, s.Duration, s.Allocs, s.Bytes, s.Steps)
+	}
+	if err := tw.Flush(); err != nil {
+		return err
+	}
+
+	for _, q := range []struct {
+		label string
+		fits  []Fit
+	}{{"time", r.Time}, {"allocs", r.Allocs}, {"steps", r.Steps}} {
+		class, confidence, ok := Best(q.fits)
+		if !ok {
+			continue
+		}
+		if _, err := fmt.Fprintf(w, "%-7s %-11s confidence %5.1f%%  R² %.4f\n",
+			q.label+":", class, confidence*100, q.fits[0].R2); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// WriteCSV writes one record per sample with a header row.
+func (r Result) WriteCSV(w io.Writer) error {
+	cw := csv.NewWriter(w)
+	cw.Write([]string{"name", "n", "nanoseconds", "allocs", "bytes", "steps"})
+	for _, s := range r.Samples {
+		cw.Write([]string{
+			r.Name,
+			strconv.Itoa(s.N),
+			strconv.FormatInt(s.Duration.Nanoseconds(), 10),
+			strconv.FormatUint(s.Allocs, 10),
+			strconv.FormatUint(s.Bytes, 10),
+			strconv.Itoa(s.Steps),
+		})
+	}
+
+	cw.Flush()
+	return cw.Error()
+}
@@ -0,0 +1,34 @@
+// Package cache provides fixed-capacity caches built from a hash table, for
+// O(1) lookups, and a doubly linked list, for O(1) reordering and eviction.
+// The caches are not safe for concurrent use.
+package cache
+
+// Stats counts how lookups through Get went.
+type Stats struct {
+	Hits      int
+	Misses    int
+	Evictions int
+}
+
+// HitRate returns the fraction of lookups that were hits, or 0 if there
+// were none.
+func (s Stats) HitRate() float64 {
+	total := s.Hits + s.Misses
+	if total == 0 {
+		return 0
+	}
+
+	return float64(s.Hits) / float64(total)
+}
+
+type entry[K comparable, V any] struct {
+	key       K
+	value     V
+	frequency int
+}
+
+func checkCapacity(capacity int) {
+	if capacity <= 0 {
+		panic("cache: capacity must be positive")
+	}
+}
@@ -0,0 +1,163 @@
+package cache
+
+import "github.com/devluxor/common-sense-guide-to-dsa/go_exercises/list"
+
+// LFUCache evicts the least frequently used entry when it is full, breaking
+// ties by evicting the least recently used among them. Entries are grouped
+// in one list per use count, and the cache remembers the smallest count in
+// use, so Get and Put are O(1). Remove is O(1) too, except when it takes
+// the last entry with the smallest count: then it scans the F distinct
+// counts still in use, O(F).
+type LFUCache[K comparable, V any] struct {
+	capacity     int
+	items        map[K]*list.DoublyNode[*entry[K, V]]
+	frequencies  map[int]*list.DoublyList[*entry[K, V]]
+	minFrequency int
+	onEvict      func(key K, value V)
+	stats        Stats
+}
+
+// NewLFU returns an empty cache holding up to capacity entries. onEvict, if
+// not nil, is called with every entry pushed out to make room. NewLFU
+// panics if capacity is not positive.
+func NewLFU[K comparable, V any](capacity int, onEvict func(key K, value V)) *LFUCache[K, V] {
+	checkCapacity(capacity)
+
+	return &LFUCache[K, V]{
+		capacity:    capacity,
+		items:       make(map[K]*list.DoublyNode[*entry[K, V]], capacity),
+		frequencies: make(map[int]*list.DoublyList[*entry[K, V]]),
+		onEvict:     onEvict,
+	}
+}
+
+// bucket returns the list of entries used exactly frequency times,
+// creating it if needed.
+func (c *LFUCache[K, V]) bucket(frequency int) *list.DoublyList[*entry[K, V]] {
+	b, ok := c.frequencies[frequency]
+	if !ok {
+		b = list.NewDoubly[*entry[K, V]]()
+		c.frequencies[frequency] = b
+	}
+
+	return b
+}
+
+// detach removes node from its frequency list, dropping the list once it
+// is empty.
+func (c *LFUCache[K, V]) detach(node *list.DoublyNode[*entry[K, V]]) {
+	frequency := node.Data.frequency
+	b := c.frequencies[frequency]
+	b.Remove(node)
+
+	if b.Len() == 0 {
+		delete(c.frequencies, frequency)
+	}
+}
+
+// touch moves the entry at node up to the next frequency list.
+func (c *LFUCache[K, V]) touch(node *list.DoublyNode[*entry[K, V]]) {
+	e := node.Data
+	c.detach(node)
+
+	if e.frequency == c.minFrequency && c.frequencies[e.frequency] == nil {
+		c.minFrequency++
+	}
+
+	e.frequency++
+	c.items[e.key] = c.bucket(e.frequency).PushFront(e)
+}
+
+// Get returns the value cached under key and counts one more use of it.
+func (c *LFUCache[K, V]) Get(key K) (V, bool) {
+	node, ok := c.items[key]
+	if !ok {
+		c.stats.Misses++
+		var zero V
+		return zero, false
+	}
+
+	c.stats.Hits++
+	c.touch(node)
+	return node.Data.value, true
+}
+
+// Peek returns the value cached under key without counting a use or
+// touching the statistics.
+func (c *LFUCache[K, V]) Peek(key K) (V, bool) {
+	node, ok := c.items[key]
+	if !ok {
+		var zero V
+		return zero, false
+	}
+
+	return node.Data.value, true
+}
+
+// Put caches value under key. Updating an existing key counts as a use; a
+// new key starts with a single use, after evicting the least frequently
+// used entry if the cache is full.
+func (c *LFUCache[K, V]) Put(key K, value V) {
+	if node, ok := c.items[key]; ok {
+		node.Data.value = value
+		c.touch(node)
+		return
+	}
+
+	if len(c.items) == c.capacity {
+		victim := c.frequencies[c.minFrequency].Tail()
+		c.detach(victim)
+		delete(c.items, victim.Data.key)
+		c.stats.Evictions++
+
+		if c.onEvict != nil {
+			c.onEvict(victim.Data.key, victim.Data.value)
+		}
+	}
+
+	c.minFrequency = 1
+	c.items[key] = c.bucket(1).PushFront(&entry[K, V]{key: key, value: value, frequency: 1})
+}
+
+// Remove deletes key from the cache and reports whether it was present.
+// The eviction callback is not called. Removing the last entry with the
+// smallest use count costs O(F) for F distinct counts in use, to find the
+// next smallest; any other removal is O(1).
+func (c *LFUCache[K, V]) Remove(key K) bool {
+	node, ok := c.items[key]
+	if !ok {
+		return false
+	}
+
+	c.detach(node)
+	delete(c.items, key)
+
+	// the smallest frequency may be gone now; find the next one in use
+	if len(c.items) == 0 {
+		c.minFrequency = 0
+	} else if c.frequencies[c.minFrequency] == nil {
+		c.minFrequency = 0
+		for f := range c.frequencies {
+			if c.minFrequency == 0 || f < c.minFrequency {
+				c.minFrequency = f
+			}
+		}
+	}
+
+	return true
+}
+
+// Len returns the number of cached entries.
+func (c *LFUCache[K, V]) Len() int {
+	return len(c.items)
+}
+
+// Cap returns the maximum number of entries.
+func (c *LFUCache[K, V]) Cap() int {
+	return c.capacity
+}
+
+// Stats returns the hit, miss and eviction counts so far.
+func (c *LFUCache[K, V]) Stats() Stats {
+	return c.stats
+}
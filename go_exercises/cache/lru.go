@@ -0,0 +1,105 @@
+package cache
+
+import "github.com/devluxor/common-sense-guide-to-dsa/go_exercises/list"
+
+// LRUCache evicts the least recently used entry when it is full. The list
+// keeps entries from most to least recently used and the map points every
+// key at its node, so Get, Put and Remove are all O(1).
+type LRUCache[K comparable, V any] struct {
+	capacity int
+	items    map[K]*list.DoublyNode[*entry[K, V]]
+	order    list.DoublyList[*entry[K, V]]
+	onEvict  func(key K, value V)
+	stats    Stats
+}
+
+// NewLRU returns an empty cache holding up to capacity entries. onEvict, if
+// not nil, is called with every entry pushed out to make room. NewLRU
+// panics if capacity is not positive.
+func NewLRU[K comparable, V any](capacity int, onEvict func(key K, value V)) *LRUCache[K, V] {
+	checkCapacity(capacity)
+
+	return &LRUCache[K, V]{
+		capacity: capacity,
+		items:    make(map[K]*list.DoublyNode[*entry[K, V]], capacity),
+		onEvict:  onEvict,
+	}
+}
+
+// Get returns the value cached under key and marks it as the most recently
+// used.
+func (c *LRUCache[K, V]) Get(key K) (V, bool) {
+	node, ok := c.items[key]
+	if !ok {
+		c.stats.Misses++
+		var zero V
+		return zero, false
+	}
+
+	c.stats.Hits++
+	c.order.MoveToFront(node)
+	return node.Data.value, true
+}
+
+// Peek returns the value cached under key without touching its recency or
+// the statistics.
+func (c *LRUCache[K, V]) Peek(key K) (V, bool) {
+	node, ok := c.items[key]
+	if !ok {
+		var zero V
+		return zero, false
+	}
+
+	return node.Data.value, true
+}
+
+// Put caches value under key as the most recently used entry, evicting the
+// least recently used one if the cache is full.
+func (c *LRUCache[K, V]) Put(key K, value V) {
+	if node, ok := c.items[key]; ok {
+		node.Data.value = value
+		c.order.MoveToFront(node)
+		return
+	}
+
+	if len(c.items) == c.capacity {
+		oldest := c.order.Tail()
+		c.order.Remove(oldest)
+		delete(c.items, oldest.Data.key)
+		c.stats.Evictions++
+
+		if c.onEvict != nil {
+			c.onEvict(oldest.Data.key, oldest.Data.value)
+		}
+	}
+
+	c.items[key] = c.order.PushFront(&entry[K, V]{key: key, value: value})
+}
+
+// Remove deletes key from the cache and reports whether it was present.
+// The eviction callback is not called.
+func (c *LRUCache[K, V]) Remove(key K) bool {
+	node, ok := c.items[key]
+	if !ok {
+		return false
+	}
+
+	c.order.Remove(node)
+	delete(c.items, key)
+	return true
+}
+
+// Len returns the number of cached entries.
+func (c *LRUCache[K, V]) Len() int {
+	return len(c.items)
+}
+
+// Cap returns the maximum number of entries.
+func (c *LRUCache[K, V]) Cap() int {
+	return c.capacity
+}
+
+// Stats returns the hit, miss and eviction counts so far.
+func (c *LRUCache[K, V]) Stats() Stats {
+	return c.stats
+}
@@ -0,0 +1,181 @@
+// Command bigo profiles one of the repository's algorithms over growing
+// input sizes and reports which Big O class fits its running time,
+// allocations and step counts best.
+//
+// Usage:
+//
+//	bigo [-sizes n,n,...] [-repeats n] [-csv file] target
+//
+// Run bigo -list to see the available targets. The table goes to standard
+// output; -csv also writes the raw samples to file, or writes only them to
+// standard output when file is "-".
+package main
+
+import (
+	"cmp"
+	"flag"
+	"fmt"
+	"io"
+	"maps"
+	"math/rand/v2"
+	"os"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/devluxor/common-sense-guide-to-dsa/go_exercises/bigo"
+	"github.com/devluxor/common-sense-guide-to-dsa/go_exercises/hashing"
+	"github.com/devluxor/common-sense-guide-to-dsa/go_exercises/sorting"
+	"github.com/devluxor/common-sense-guide-to-dsa/go_exercises/steps"
+)
+
+// target profiles a single algorithm with the given options.
+type target struct {
+	description string
+	profile     func(name string, opts bigo.Options) (bigo.Result, error)
+}
+
+func randomInts(n int) []int {
+	s := make([]int, n)
+	for i := range s {
+		s[i] = rand.IntN(n * 4)
+	}
+	return s
+}
+
+func sortedInts(n int) []int {
+	s := make([]int, n)
+	for i := range s {
+		s[i] = i * 2
+	}
+	return s
+}
+
+func twoSlices(n int) [2][]int {
+	return [2][]int{randomInts(n), randomInts(n)}
+}
+
+func descendingInts(n int) []int {
+	s := sortedInts(n)
+	slices.Reverse(s)
+	return s
+}
+
+var targets = map[string]target{
+	"longestsequence": {"hashing.LongestSequence on random integers", func(name string, opts bigo.Options) (bigo.Result, error) {
+		return bigo.Profile(name, randomInts, func(s []int, c *steps.Counter) {
+			hashing.LongestSequenceCounted(s, c)
+		}, opts)
+	}},
+	"intersection": {"hashing.Common of two random slices", func(name string, opts bigo.Options) (bigo.Result, error) {
+		return bigo.Profile(name, twoSlices, func(in [2][]int, c *steps.Counter) {
+			hashing.CommonCounted(in[0], in[1], func(v int) int { return v }, c)
+		}, opts)
+	}},
+	"twosum": {"hashing.TwoSum with an unreachable target", func(name string, opts bigo.Options) (bigo.Result, error) {
+		return bigo.Profile(name, randomInts, func(s []int, c *steps.Counter) {
+			hashing.TwoSumCounted(s, -1, c)
+		}, opts)
+	}},
+	"binarysearch": {"sorting.BinarySearch for a missing value", func(name string, opts bigo.Options) (bigo.Result, error) {
+		return bigo.Profile(name, sortedInts, func(s []int, c *steps.Counter) {
+			sorting.BinarySearchCounted(s, 1, c)
+		}, opts)
+	}},
+	"quicksort": {"sorting.Quicksort on random integers", func(name string, opts bigo.Options) (bigo.Result, error) {
+		return bigo.Profile(name, randomInts, func(s []int, c *steps.Counter) {
+			sorting.QuicksortCounted(s, c)
+		}, opts)
+	}},
+	"sort": {"sorting.SortFunc on random integers", func(name string, opts bigo.Options) (bigo.Result, error) {
+		return bigo.Profile(name, randomInts, func(s []int, c *steps.Counter) {
+			sorting.SortFunc(s, steps.CountCompare(c, cmp.Compare[int]))
+		}, opts)
+	}},
+	"insertionsort": {"insertion sort on descending integers", func(name string, opts bigo.Options) (bigo.Result, error) {
+		return bigo.Profile(name, descendingInts, func(s []int, c *steps.Counter) {
+			for i := 1; i < len(s); i++ {
+				for j := i; j > 0; j-- {
+					c.Compare()
+					if s[j] >= s[j-1] {
+						break
+					}
+					s[j], s[j-1] = s[j-1], s[j]
+					c.Swap()
+				}
+			}
+		}, opts)
+	}},
+}
+
+func main() {
+	sizes := flag.String("sizes", "", "comma-separated input sizes (default 256 to 32768, doubling)")
+	repeats := flag.Int("repeats", bigo.DefaultRepeats, "runs per size; the fastest is kept")
+	csvFile := flag.String("csv", "", `also write the samples as CSV to this file ("-" for standard output)`)
+	list := flag.Bool("list", false, "list the available targets")
+	flag.Usage = func() {
+		fmt.Fprintln(os.Stderr, "usage: bigo [-sizes n,n,...] [-repeats n] [-csv file] target")
+		flag.PrintDefaults()
+	}
+	flag.Parse()
+
+	if *list {
+		for _, name := range slices.Sorted(maps.Keys(targets)) {
+			fmt.Printf("%-16s %s\n", name, targets[name].description)
+		}
+		return
+	}
+
+	if flag.NArg() != 1 {
+		flag.Usage()
+		os.Exit(2)
+	}
+
+	if err := run(flag.Arg(0), *sizes, *repeats, *csvFile); err != nil {
+		fmt.Fprintf(os.Stderr, "bigo: %v\n", err)
+		os.Exit(1)
+	}
+}
+
+func run(name, sizes string, repeats int, csvFile string) error {
+	t, ok := targets[name]
+	if !ok {
+		return fmt.Errorf("unknown target %q; see bigo -list", name)
+	}
+
+	opts := bigo.Options{Repeats: repeats}
+	if sizes != "" {
+		for _, field := range strings.Split(sizes, ",") {
+			n, err := strconv.Atoi(strings.TrimSpace(field))
+			if err != nil {
+				return fmt.Errorf("invalid size %q", field)
+			}
+			opts.Sizes = append(opts.Sizes, n)
+		}
+	}
+
+	result, err := t.profile(name, opts)
+	if err != nil {
+		return err
+	}
+
+	if csvFile != "" {
+		var w io.Writer = os.Stdout
+		if csvFile != "-" {
+			f, err := os.Create(csvFile)
+			if err != nil {
+				return err
+			}
+			defer f.Close()
+			w = f
+		}
+		if err := result.WriteCSV(w); err != nil {
+			return err
+		}
+		if csvFile == "-" {
+			return nil
+		}
+	}
+
+	return result.WriteTable(os.Stdout)
+}
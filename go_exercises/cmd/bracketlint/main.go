@@ -0,0 +1,92 @@
+// Command bracketlint reports unbalanced and mismatched brackets.
+//
+// Usage:
+//
+//	bracketlint [-lang name] [file ...]
+//
+// With no files it reads standard input. The language is picked from each
+// file's extension unless -lang is given. Every issue is printed as
+// file:line:column: message. The exit status is 1 if any issue was found
+// and 2 if a file could not be read, which makes it usable as a pre-commit
+// hook.
+package main
+
+import (
+	"flag"
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/devluxor/common-sense-guide-to-dsa/go_exercises/linter"
+)
+
+func main() {
+	langName := flag.String("lang", "", "language of the input: "+languageNames())
+	flag.Usage = func() {
+		fmt.Fprintln(os.Stderr, "usage: bracketlint [-lang name] [file ...]")
+		flag.PrintDefaults()
+	}
+	flag.Parse()
+
+	var forced *linter.Language
+	if *langName != "" {
+		lang, ok := linter.LanguageNamed(*langName)
+		if !ok {
+			fmt.Fprintf(os.Stderr, "bracketlint: unknown language %q\n", *langName)
+			os.Exit(2)
+		}
+		forced = &lang
+	}
+
+	files := flag.Args()
+	if len(files) == 0 {
+		files = []string{"-"}
+	}
+
+	status := 0
+	for _, name := range files {
+		lang := linter.LanguageFor(name)
+		if forced != nil {
+			lang = *forced
+		}
+
+		issues, err := lintFile(name, lang)
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "bracketlint: %v\n", err)
+			status = 2
+			continue
+		}
+
+		for _, issue := range issues {
+			fmt.Printf("%s:%v\n", name, issue)
+		}
+		if len(issues) > 0 && status == 0 {
+			status = 1
+		}
+	}
+
+	os.Exit(status)
+}
+
+func lintFile(name string, lang linter.Language) ([]linter.Issue, error) {
+	if name == "-" {
+		return linter.Lint(os.Stdin, lang)
+	}
+
+	f, err := os.Open(name)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+
+	return linter.Lint(f, lang)
+}
+
+func languageNames() string {
+	names := []string{}
+	for _, l := range linter.Languages {
+		names = append(names, l.Name)
+	}
+
+	return strings.Join(names, ", ")
+}
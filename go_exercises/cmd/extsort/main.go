@@ -0,0 +1,140 @@
+// Command extsort sorts lines of text like sort(1), but keeps at most a
+// fixed amount of them in memory, spilling sorted runs to temporary files
+// and merging them at the end. Equal lines keep their input order.
+//
+// Usage:
+//
+//	extsort [-S size] [-T dir] [-r] [-o file] [file ...]
+//
+// With no files it reads standard input. Sizes accept a K, M or G suffix.
+package main
+
+import (
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+
+	"github.com/devluxor/common-sense-guide-to-dsa/go_exercises/extsort"
+)
+
+func main() {
+	size := flag.String("S", "64M", "memory budget for in-memory runs")
+	tempDir := flag.String("T", "", "directory for temporary run files")
+	reverse := flag.Bool("r", false, "sort in descending order")
+	output := flag.String("o", "", "write the result to this file instead of standard output")
+	flag.Usage = func() {
+		fmt.Fprintln(os.Stderr, "usage: extsort [-S size] [-T dir] [-r] [-o file] [file ...]")
+		flag.PrintDefaults()
+	}
+	flag.Parse()
+
+	if err := run(*size, *tempDir, *reverse, *output, flag.Args()); err != nil {
+		fmt.Fprintf(os.Stderr, "extsort: %v\n", err)
+		os.Exit(1)
+	}
+}
+
+func run(size, tempDir string, reverse bool, output string, files []string) error {
+	limit, err := parseSize(size)
+	if err != nil {
+		return err
+	}
+
+	opts := extsort.Options{MemoryLimit: limit, TempDir: tempDir}
+	if reverse {
+		opts.Compare = func(a, b string) int { return strings.Compare(b, a) }
+	}
+
+	var input io.Reader = os.Stdin
+	if len(files) > 0 {
+		readers := []io.Reader{}
+		for _, name := range files {
+			f, err := os.Open(name)
+			if err != nil {
+				return err
+			}
+			defer f.Close()
+			readers = append(readers, &lineTerminated{r: f, last: '\n'})
+		}
+		input = io.MultiReader(readers...)
+	}
+
+	if output == "" {
+		return extsort.Sort(input, os.Stdout, opts)
+	}
+
+	// sort into a temporary file next to the output and rename it at the
+	// end, so -o may name one of the inputs
+	tmp, err := os.CreateTemp(filepath.Dir(output), ".extsort-*")
+	if err != nil {
+		return err
+	}
+	defer os.Remove(tmp.Name())
+
+	if err := extsort.Sort(input, tmp, opts); err != nil {
+		tmp.Close()
+		return err
+	}
+	if err := tmp.Close(); err != nil {
+		return err
+	}
+
+	return os.Rename(tmp.Name(), output)
+}
+
+// lineTerminated adds a final newline to r if it lacks one, so the last
+// line of a file does not run into the first line of the next. last starts
+// as '\n' so empty files stay empty.
+type lineTerminated struct {
+	r    io.Reader
+	last byte
+}
+
+func (l *lineTerminated) Read(p []byte) (int, error) {
+	n, err := l.r.Read(p)
+	if n > 0 {
+		l.last = p[n-1]
+	}
+
+	if err == io.EOF && l.last != '\n' {
+		l.last = '\n'
+		l.r = strings.NewReader("\n")
+		return n, nil
+	}
+
+	return n, err
+}
+
+var errInvalidSize = errors.New("invalid size")
+
+// parseSize reads a byte count with an optional K, M or G suffix.
+func parseSize(s string) (int64, error) {
+	if s == "" {
+		return 0, errInvalidSize
+	}
+
+	multiplier := int64(1)
+	switch strings.ToUpper(s[len(s)-1:]) {
+	case "K":
+		multiplier = 1 << 10
+	case "M":
+		multiplier = 1 << 20
+	case "G":
+		multiplier = 1 << 30
+	}
+	if multiplier != 1 {
+		s = s[:len(s)-1]
+	}
+
+	n, err := strconv.ParseInt(s, 10, 64)
+	if err != nil || n <= 0 {
+		return 0, fmt.Errorf("%w %q", errInvalidSize, s)
+	}
+
+	return n * multiplier, nil
+}
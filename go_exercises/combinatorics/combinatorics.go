@@ -0,0 +1,120 @@
+// Package combinatorics counts arrangements exactly and enumerates them
+// lazily. Counts grow too fast for machine integers, so every counting
+// function returns a *big.Int; ToInt converts one back and reports when it
+// does not fit.
+package combinatorics
+
+import (
+	"errors"
+	"fmt"
+	"math/big"
+)
+
+// ErrOverflow is returned by ToInt for counts larger than an int can hold.
+var ErrOverflow = errors.New("combinatorics: count overflows int")
+
+// ToInt returns x as an int, or ErrOverflow if it does not fit.
+func ToInt(x *big.Int) (int, error) {
+	if !x.IsInt64() || int64(int(x.Int64())) != x.Int64() {
+		return 0, fmt.Errorf("%w: %v", ErrOverflow, x)
+	}
+
+	return int(x.Int64()), nil
+}
+
+// Binomial returns the number of ways to choose k of n items, n! / (k! ·
+// (n-k)!), or 0 when k is negative or greater than n. It panics if n is
+// negative.
+func Binomial(n, k int) *big.Int {
+	if n < 0 {
+		panic(fmt.Sprintf("combinatorics: negative n %d", n))
+	}
+	if k < 0 || k > n {
+		return big.NewInt(0)
+	}
+
+	return new(big.Int).Binomial(int64(n), int64(k))
+}
+
+// UniquePaths returns the number of shortest paths from the top-left to
+// the bottom-right cell of a grid, moving only right or down. Every path
+// makes rows-1 moves down and columns-1 moves right, so it is enough to
+// choose which of the moves go down: C(rows+columns-2, rows-1). A grid
+// without cells has no paths.
+func UniquePaths(rows, columns int) *big.Int {
+	if rows <= 0 || columns <= 0 {
+		return big.NewInt(0)
+	}
+
+	return Binomial(rows+columns-2, rows-1)
+}
+
+// UniquePathsObstacles is UniquePaths for a grid where blocked[r][c]
+// marks a cell that paths may not enter. There is no closed form, so it
+// counts row by row: the paths into a cell are the paths into the cell
+// above plus those into the cell to its left. O(R·C) time and O(C) space.
+// Rows shorter than the first are treated as blocked past their end.
+func UniquePathsObstacles(blocked [][]bool) *big.Int {
+	if len(blocked) == 0 || len(blocked[0]) == 0 {
+		return big.NewInt(0)
+	}
+
+	columns := len(blocked[0])
+	paths := make([]*big.Int, columns)
+	for c := range paths {
+		paths[c] = new(big.Int)
+	}
+	paths[0].SetInt64(1)
+
+	for _, row := range blocked {
+		for c := range paths {
+			switch {
+			case c >= len(row) || row[c]:
+				paths[c].SetInt64(0)
+			case c > 0:
+				// paths[c] still holds the count for the cell above
+				paths[c].Add(paths[c], paths[c-1])
+			}
+		}
+	}
+
+	return paths[columns-1]
+}
+
+// Catalan returns the nth Catalan number, C(2n, n) / (n+1): the number of
+// balanced strings of n pairs of brackets, of binary trees with n nodes,
+// and of monotonic paths through an n×n grid that never cross the
+// diagonal. It panics if n is negative.
+func Catalan(n int) *big.Int {
+	if n < 0 {
+		panic(fmt.Sprintf("combinatorics: negative n %d", n))
+	}
+
+	c := Binomial(2*n, n)
+	return c.Quo(c, big.NewInt(int64(n+1)))
+}
+
+// Staircase returns the number of ways to climb n stairs taking, at every
+// move, one of the given numbers of steps, where the order of the moves
+// matters. The ways to reach a stair are the sums of the ways to reach the
+// stairs one move below it: O(N·S) time. Staircase(n, []int{1, 2, 3}) is
+// the staircase problem of chapter 11. Step sizes that are not positive are
+// ignored.
+func Staircase(n int, steps []int) *big.Int {
+	if n < 0 {
+		return big.NewInt(0)
+	}
+
+	ways := make([]*big.Int, n+1)
+	ways[0] = big.NewInt(1)
+	for i := 1; i <= n; i++ {
+		ways[i] = new(big.Int)
+		for _, s := range steps {
+			if s > 0 && s <= i {
+				ways[i].Add(ways[i], ways[i-s])
+			}
+		}
+	}
+
+	return ways[n]
+}
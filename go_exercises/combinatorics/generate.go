@@ -0,0 +1,218 @@
+package combinatorics
+
+import (
+	"cmp"
+	"iter"
+	"slices"
+)
+
+// The generators below enumerate their search space lazily, one
+// arrangement at a time, so a loop that stops early never pays for the
+// rest. Generators of slices yield the same buffer every time, refilled in
+// place: clone a slice to keep it past the iteration that yielded it.
+
+// Permutations returns an iterator over the ordered selections of k of the
+// items without repetition, n! / (n-k)! of them, in lexicographic order of
+// the items' positions. Items are told apart by position, so repeated
+// values give repeated permutations; see Distinct for the alternative.
+func Permutations[T any](items []T, k int) iter.Seq[[]T] {
+	return func(yield func([]T) bool) {
+		n := len(items)
+		if k < 0 || k > n {
+			return
+		}
+
+		// index[i] is the position of the item in slot i
+		index := make([]int, k)
+		used := make([]bool, n)
+		for i := range index {
+			index[i], used[i] = i, true
+		}
+
+		buffer := make([]T, k)
+		for {
+			for i, p := range index {
+				buffer[i] = items[p]
+			}
+			if !yield(buffer) {
+				return
+			}
+
+			// move the rightmost slot that can go to a larger unused item
+			// there, then refill the slots after it with the smallest
+			// unused items
+			i := k - 1
+			for ; i >= 0; i-- {
+				used[index[i]] = false
+				next := index[i] + 1
+				for next < n && used[next] {
+					next++
+				}
+				if next < n {
+					index[i], used[next] = next, true
+					break
+				}
+			}
+			if i < 0 {
+				return
+			}
+
+			free := 0
+			for j := i + 1; j < k; j++ {
+				for used[free] {
+					free++
+				}
+				index[j], used[free] = free, true
+			}
+		}
+	}
+}
+
+// PermutationsWithRepetition returns an iterator over the n^k sequences of
+// k items where every slot may hold any of them, in lexicographic order of
+// the items' positions, like an odometer.
+func PermutationsWithRepetition[T any](items []T, k int) iter.Seq[[]T] {
+	return func(yield func([]T) bool) {
+		n := len(items)
+		if k < 0 || (n == 0 && k > 0) {
+			return
+		}
+
+		index := make([]int, k)
+		buffer := make([]T, k)
+		for {
+			for i, p := range index {
+				buffer[i] = items[p]
+			}
+			if !yield(buffer) {
+				return
+			}
+
+			i := k - 1
+			for ; i >= 0 && index[i] == n-1; i-- {
+				index[i] = 0
+			}
+			if i < 0 {
+				return
+			}
+			index[i]++
+		}
+	}
+}
+
+// NextPermutation rearranges s into the next permutation in lexicographic
+// order and reports whether there was one; after the last, descending
+// permutation it sorts s back into ascending order and returns false.
+// Equal values are interchangeable, so only distinct permutations are
+// visited. O(N) time, O(1) space.
+func NextPermutation[T cmp.Ordered](s []T) bool {
+	return NextPermutationFunc(s, cmp.Compare[T])
+}
+
+// NextPermutationFunc is NextPermutation ordering values with compare.
+func NextPermutationFunc[T any](s []T, compare func(a, b T) int) bool {
+	// the longest descending suffix is already its last permutation
+	i := len(s) - 2
+	for i >= 0 && compare(s[i], s[i+1]) >= 0 {
+		i--
+	}
+	if i < 0 {
+		slices.Reverse(s)
+		return false
+	}
+
+	// swap in the smallest value of the suffix greater than s[i]; the
+	// suffix stays descending, so reversing it makes it ascending
+	j := len(s) - 1
+	for compare(s[j], s[i]) <= 0 {
+		j--
+	}
+	s[i], s[j] = s[j], s[i]
+	slices.Reverse(s[i+1:])
+	return true
+}
+
+// Distinct returns an iterator over the distinct permutations of values in
+// lexicographic order. It sorts a copy of values and steps through it with
+// NextPermutation, so n values with repeats yield n! / (m1!·m2!·...)
+// permutations rather than n!.
+func Distinct[T cmp.Ordered](values []T) iter.Seq[[]T] {
+	return func(yield func([]T) bool) {
+		buffer := slices.Clone(values)
+		slices.Sort(buffer)
+		for {
+			if !yield(buffer) || !NextPermutation(buffer) {
+				return
+			}
+		}
+	}
+}
+
+// Anagrams returns an iterator over the distinct anagrams of s, rearranging
+// its runes, in lexicographic order. "aab" has three: "aab", "aba" and
+// "baa".
+func Anagrams(s string) iter.Seq[string] {
+	return func(yield func(string) bool) {
+		for runes := range Distinct([]rune(s)) {
+			if !yield(string(runes)) {
+				return
+			}
+		}
+	}
+}
+
+// Combinations returns an iterator over the C(n, k) ways to choose k of
+// the items regardless of order, each in the order the items appear, in
+// lexicographic order of their positions.
+func Combinations[T any](items []T, k int) iter.Seq[[]T] {
+	return func(yield func([]T) bool) {
+		n := len(items)
+		if k < 0 || k > n {
+			return
+		}
+
+		index := make([]int, k)
+		for i := range index {
+			index[i] = i
+		}
+
+		buffer := make([]T, k)
+		for {
+			for i, p := range index {
+				buffer[i] = items[p]
+			}
+			if !yield(buffer) {
+				return
+			}
+
+			// slot i can hold at most position n-k+i, leaving room for the
+			// slots after it
+			i := k - 1
+			for i >= 0 && index[i] == n-k+i {
+				i--
+			}
+			if i < 0 {
+				return
+			}
+			index[i]++
+			for j := i + 1; j < k; j++ {
+				index[j] = index[j-1] + 1
+			}
+		}
+	}
+}
+
+// PowerSet returns an iterator over all 2^n subsets of items, from the
+// empty set up to the whole, smaller subsets first and each size in the
+// order of Combinations.
+func PowerSet[T any](items []T) iter.Seq[[]T] {
+	return func(yield func([]T) bool) {
+		for k := 0; k <= len(items); k++ {
+			for subset := range Combinations(items, k) {
+				if !yield(subset) {
+					return
+				}
+			}
+		}
+	}
+}
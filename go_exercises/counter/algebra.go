@@ -0,0 +1,62 @@
+package counter
+
+// The multiset operations below return a new counter. Its items come in the
+// first-seen order of c, followed by the items only other has in their
+// first-seen order there.
+
+// Union returns the counter holding every item with the larger of its two
+// counts.
+func (c *Counter[T]) Union(other *Counter[T]) *Counter[T] {
+	return c.combine(other, func(a, b int) int { return max(a, b) })
+}
+
+// Intersection returns the counter holding the items of both counters with
+// the smaller of their two counts.
+func (c *Counter[T]) Intersection(other *Counter[T]) *Counter[T] {
+	return c.combine(other, func(a, b int) int { return min(a, b) })
+}
+
+// Difference returns the counter holding the items of c whose count
+// exceeds their count in other, by how much it does.
+func (c *Counter[T]) Difference(other *Counter[T]) *Counter[T] {
+	return c.combine(other, func(a, b int) int { return a - b })
+}
+
+// Sum returns the counter holding every item with its two counts added.
+func (c *Counter[T]) Sum(other *Counter[T]) *Counter[T] {
+	return c.combine(other, func(a, b int) int { return a + b })
+}
+
+// Includes reports whether every item of other occurs in c at least as
+// many times.
+func (c *Counter[T]) Includes(other *Counter[T]) bool {
+	for item, count := range other.All() {
+		if c.Count(item) < count {
+			return false
+		}
+	}
+
+	return true
+}
+
+// Equal reports whether both counters hold the same items with the same
+// counts, in any order.
+func (c *Counter[T]) Equal(other *Counter[T]) bool {
+	return c.Len() == other.Len() && c.Total() == other.Total() && c.Includes(other)
+}
+
+// combine applies merge to the counts of every item of either counter,
+// reading a missing item as 0, and keeps the positive results.
+func (c *Counter[T]) combine(other *Counter[T], merge func(a, b int) int) *Counter[T] {
+	result := &Counter[T]{}
+	for item, count := range c.All() {
+		result.AddN(item, merge(count, other.Count(item)))
+	}
+	for item, count := range other.All() {
+		if c.Count(item) == 0 {
+			result.AddN(item, merge(0, count))
+		}
+	}
+
+	return result
+}
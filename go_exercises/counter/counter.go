@@ -0,0 +1,210 @@
+// Package counter provides a multiset: a hash table from items to how many
+// times they occur, for the frequency-counting problems that would
+// otherwise each build their own map[T]int.
+package counter
+
+import (
+	"cmp"
+	"iter"
+	"slices"
+
+	"github.com/devluxor/common-sense-guide-to-dsa/go_exercises/list"
+)
+
+// Entry is an item of a Counter and its count.
+type Entry[T comparable] struct {
+	Item  T
+	Count int
+}
+
+// Counter is a multiset that remembers the order in which items were first
+// added. Every item in it has a positive count. The hash table finds an
+// item's entry in O(1), and the entries are kept in a doubly linked list so
+// an item whose count drops to zero is unlinked in O(1) too. The zero value
+// is an empty counter ready to use.
+type Counter[T comparable] struct {
+	entries list.DoublyList[Entry[T]]
+	nodes   map[T]*list.DoublyNode[Entry[T]]
+	total   int
+}
+
+// New returns a counter holding items, each counted once per occurrence.
+func New[T comparable](items ...T) *Counter[T] {
+	c := &Counter[T]{}
+	for _, item := range items {
+		c.Add(item)
+	}
+
+	return c
+}
+
+// Add counts one more occurrence of item.
+func (c *Counter[T]) Add(item T) {
+	c.AddN(item, 1)
+}
+
+// AddN counts n more occurrences of item. It does nothing if n is not
+// positive.
+func (c *Counter[T]) AddN(item T, n int) {
+	if n <= 0 {
+		return
+	}
+	if c.nodes == nil {
+		c.nodes = make(map[T]*list.DoublyNode[Entry[T]])
+	}
+
+	if node, ok := c.nodes[item]; ok {
+		node.Data.Count += n
+	} else {
+		c.nodes[item] = c.entries.PushBack(Entry[T]{Item: item, Count: n})
+	}
+	c.total += n
+}
+
+// Remove takes away one occurrence of item and reports whether there was
+// one.
+func (c *Counter[T]) Remove(item T) bool {
+	return c.RemoveN(item, 1) == 1
+}
+
+// RemoveN takes away up to n occurrences of item and returns how many it
+// removed. An item whose count reaches zero is forgotten, so adding it
+// again places it last in iteration order.
+func (c *Counter[T]) RemoveN(item T, n int) int {
+	node, ok := c.nodes[item]
+	if !ok || n <= 0 {
+		return 0
+	}
+
+	removed := min(n, node.Data.Count)
+	node.Data.Count -= removed
+	c.total -= removed
+	if node.Data.Count == 0 {
+		c.entries.Remove(node)
+		delete(c.nodes, item)
+	}
+
+	return removed
+}
+
+// Delete forgets item entirely and returns the count it had.
+func (c *Counter[T]) Delete(item T) int {
+	return c.RemoveN(item, c.Count(item))
+}
+
+// Count returns how many times item occurs, 0 if it does not.
+func (c *Counter[T]) Count(item T) int {
+	if node, ok := c.nodes[item]; ok {
+		return node.Data.Count
+	}
+
+	return 0
+}
+
+// Len returns the number of distinct items.
+func (c *Counter[T]) Len() int {
+	return c.entries.Len()
+}
+
+// Total returns the sum of all counts.
+func (c *Counter[T]) Total() int {
+	return c.total
+}
+
+// Clear removes every item.
+func (c *Counter[T]) Clear() {
+	*c = Counter[T]{}
+}
+
+// Clone returns a copy of c with the same iteration order.
+func (c *Counter[T]) Clone() *Counter[T] {
+	clone := &Counter[T]{}
+	for item, count := range c.All() {
+		clone.AddN(item, count)
+	}
+
+	return clone
+}
+
+// All returns an iterator over the items and their counts, in the order the
+// items were first added.
+func (c *Counter[T]) All() iter.Seq2[T, int] {
+	return func(yield func(T, int) bool) {
+		for e := range c.entries.All() {
+			if !yield(e.Item, e.Count) {
+				return
+			}
+		}
+	}
+}
+
+// Items returns the distinct items in first-seen order.
+func (c *Counter[T]) Items() []T {
+	items := make([]T, 0, c.Len())
+	for item := range c.All() {
+		items = append(items, item)
+	}
+
+	return items
+}
+
+// Entries returns the items and their counts in first-seen order.
+func (c *Counter[T]) Entries() []Entry[T] {
+	return slices.Collect(c.entries.All())
+}
+
+// Elements returns every occurrence of every item, repeating each item as
+// many times as it was counted, grouped in first-seen order.
+func (c *Counter[T]) Elements() []T {
+	elements := make([]T, 0, c.total)
+	for item, count := range c.All() {
+		for range count {
+			elements = append(elements, item)
+		}
+	}
+
+	return elements
+}
+
+// MostCommon returns the n entries with the highest counts, from the most
+// common down; items with equal counts keep their first-seen order. A
+// negative n returns every entry.
+func (c *Counter[T]) MostCommon(n int) []Entry[T] {
+	entries := c.Entries()
+	slices.SortStableFunc(entries, func(a, b Entry[T]) int {
+		return cmp.Compare(b.Count, a.Count)
+	})
+
+	if n >= 0 && n < len(entries) {
+		entries = entries[:n]
+	}
+	return entries
+}
+
+// SortedFunc returns the entries ordered by compare.
+func (c *Counter[T]) SortedFunc(compare func(a, b Entry[T]) int) []Entry[T] {
+	entries := c.Entries()
+	slices.SortStableFunc(entries, compare)
+	return entries
+}
+
+// Sorted returns the entries of c in ascending order of their items.
+func Sorted[T cmp.Ordered](c *Counter[T]) []Entry[T] {
+	return c.SortedFunc(func(a, b Entry[T]) int {
+		return cmp.Compare(a.Item, b.Item)
+	})
+}
+
+// SortedElements returns every occurrence of every item in ascending order,
+// like Elements followed by a sort but in O(N + K log K) for K distinct
+// items.
+func SortedElements[T cmp.Ordered](c *Counter[T]) []T {
+	elements := make([]T, 0, c.total)
+	for _, e := range Sorted(c) {
+		for range e.Count {
+			elements = append(elements, e.Item)
+		}
+	}
+
+	return elements
+}
@@ -0,0 +1,125 @@
+package counter
+
+import (
+	"slices"
+	"testing"
+)
+
+func TestAddRemoveCount(t *testing.T) {
+	c := New([]rune("mississippi")...)
+
+	if c.Len() != 4 || c.Total() != 11 {
+		t.Fatalf("Len, Total = %d, %d, want 4, 11", c.Len(), c.Total())
+	}
+	for r, want := range map[rune]int{'m': 1, 'i': 4, 's': 4, 'p': 2, 'x': 0} {
+		if got := c.Count(r); got != want {
+			t.Errorf("Count(%q) = %d, want %d", r, got, want)
+		}
+	}
+
+	if got := c.RemoveN('s', 10); got != 4 {
+		t.Errorf("RemoveN('s', 10) = %d, want 4", got)
+	}
+	if c.Remove('x') {
+		t.Errorf("Remove('x') = true for a missing item")
+	}
+	if got := c.Delete('i'); got != 4 {
+		t.Errorf("Delete('i') = %d, want 4", got)
+	}
+	c.AddN('z', 0)
+	c.AddN('z', -3)
+	if c.Count('z') != 0 {
+		t.Errorf("non-positive AddN counted the item")
+	}
+
+	// 's' was forgotten, so adding it again puts it last
+	c.Add('s')
+	want := []Entry[rune]{{'m', 1}, {'p', 2}, {'s', 1}}
+	if got := c.Entries(); !slices.Equal(got, want) {
+		t.Errorf("Entries() = %v, want %v", got, want)
+	}
+	if c.Total() != 4 {
+		t.Errorf("Total() = %d, want 4", c.Total())
+	}
+}
+
+func TestZeroValue(t *testing.T) {
+	var c Counter[string]
+	if c.Count("a") != 0 || c.Remove("a") || c.Len() != 0 {
+		t.Fatal("zero Counter is not empty")
+	}
+	c.Add("a")
+	if c.Count("a") != 1 {
+		t.Fatal("zero Counter does not count")
+	}
+	c.Clear()
+	if c.Len() != 0 || c.Total() != 0 {
+		t.Fatal("Clear left items behind")
+	}
+}
+
+func TestMostCommon(t *testing.T) {
+	c := New("b", "a", "c", "a", "b", "d")
+	tests := []struct {
+		n    int
+		want []Entry[string]
+	}{
+		{0, []Entry[string]{}},
+		// ties keep first-seen order
+		{2, []Entry[string]{{"b", 2}, {"a", 2}}},
+		{10, []Entry[string]{{"b", 2}, {"a", 2}, {"c", 1}, {"d", 1}}},
+		{-1, []Entry[string]{{"b", 2}, {"a", 2}, {"c", 1}, {"d", 1}}},
+	}
+
+	for _, test := range tests {
+		if got := c.MostCommon(test.n); !slices.Equal(got, test.want) {
+			t.Errorf("MostCommon(%d) = %v, want %v", test.n, got, test.want)
+		}
+	}
+}
+
+func TestAlgebra(t *testing.T) {
+	a := New([]rune("mississippi")...)
+	b := New([]rune("pipes")...)
+
+	tests := []struct {
+		name string
+		got  *Counter[rune]
+		want string
+	}{
+		{"union", a.Union(b), "miiiissssppe"},
+		{"intersection", a.Intersection(b), "ispp"},
+		{"difference", a.Difference(b), "miiisss"},
+		{"sum", a.Sum(b), "miiiiisssssppppe"},
+	}
+
+	for _, test := range tests {
+		if got := string(test.got.Elements()); got != test.want {
+			t.Errorf("%s = %q, want %q", test.name, got, test.want)
+		}
+	}
+
+	if !a.Includes(a.Intersection(b)) || a.Includes(b) {
+		t.Error("Includes is wrong")
+	}
+	if !a.Equal(a.Clone()) || a.Equal(b) {
+		t.Error("Equal is wrong")
+	}
+	if a.Total() != 11 || b.Total() != 5 {
+		t.Error("the operations modified their operands")
+	}
+}
+
+func TestSorted(t *testing.T) {
+	c := New(3, 1, 2, 3, 1, 3)
+	want := []Entry[int]{{1, 2}, {2, 1}, {3, 3}}
+	if got := Sorted(c); !slices.Equal(got, want) {
+		t.Errorf("Sorted() = %v, want %v", got, want)
+	}
+	if got := SortedElements(c); !slices.Equal(got, []int{1, 1, 2, 3, 3, 3}) {
+		t.Errorf("SortedElements() = %v", got)
+	}
+	if got := c.Items(); !slices.Equal(got, []int{3, 1, 2}) {
+		t.Errorf("Items() = %v, want first-seen order", got)
+	}
+}
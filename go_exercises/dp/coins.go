@@ -0,0 +1,138 @@
+package dp
+
+import (
+	"fmt"
+	"slices"
+
+	"github.com/devluxor/common-sense-guide-to-dsa/go_exercises/memo"
+)
+
+// Unreachable marks the amounts MinCoins cannot make up in its table.
+const Unreachable = -1
+
+// MinCoins returns the fewest coins that add up to amount, with an
+// unlimited supply of every denomination in coins, or false if no
+// combination does. The table has one row: the fewest coins for every
+// amount up to the target, or Unreachable. O(N·A) time.
+func MinCoins(coins []int, amount int) ([]int, bool, *Table) {
+	checkNonNegative("amount", amount)
+	checkPositive("coin", coins...)
+
+	t := newTable(1, amount+1)
+	t.RowLabels = []string{"coins"}
+	t.ColumnLabels = numberLabels(amount + 1)
+	fewest := t.Cells[0]
+	// last[a] is the coin added last to make up a
+	last := make([]int, amount+1)
+
+	for a := 1; a <= amount; a++ {
+		fewest[a] = Unreachable
+		for _, coin := range coins {
+			if coin > a || fewest[a-coin] == Unreachable {
+				continue
+			}
+			if fewest[a] == Unreachable || fewest[a-coin]+1 < fewest[a] {
+				fewest[a], last[a] = fewest[a-coin]+1, coin
+			}
+		}
+	}
+
+	if fewest[amount] == Unreachable {
+		return nil, false, t
+	}
+
+	used := []int{}
+	for a := amount; a > 0; a -= last[a] {
+		used = append(used, last[a])
+	}
+	slices.Reverse(used)
+	return used, true, t
+}
+
+// MinCoinsMemo is MinCoins as a memoized recursion: the fewest coins for
+// an amount is one more than the fewest for what is left after any coin.
+func MinCoinsMemo(coins []int, amount int) ([]int, bool) {
+	checkNonNegative("amount", amount)
+	checkPositive("coin", coins...)
+
+	fewest := memo.Recursive(func(self func(int) int, a int) int {
+		if a == 0 {
+			return 0
+		}
+
+		best := Unreachable
+		for _, coin := range coins {
+			if coin > a {
+				continue
+			}
+			if rest := self(a - coin); rest != Unreachable && (best == Unreachable || rest+1 < best) {
+				best = rest + 1
+			}
+		}
+		return best
+	}, memo.Options{})
+
+	if fewest.Call(amount) == Unreachable {
+		return nil, false
+	}
+
+	used := []int{}
+	for a := amount; a > 0; {
+		for _, coin := range coins {
+			if coin <= a && fewest.Call(a-coin) != Unreachable && fewest.Call(a-coin)+1 == fewest.Call(a) {
+				used = append(used, coin)
+				a -= coin
+				break
+			}
+		}
+	}
+	return used, true
+}
+
+// CoinWays returns the number of ways to make up amount from coins, with
+// an unlimited supply of each, where the order of the coins does not
+// matter. Row i of the table counts the ways using only the first i
+// denominations. O(N·A) time.
+func CoinWays(coins []int, amount int) (int, *Table) {
+	checkNonNegative("amount", amount)
+	checkPositive("coin", coins...)
+
+	t := newTable(len(coins)+1, amount+1)
+	t.RowLabels = []string{"none"}
+	t.ColumnLabels = numberLabels(amount + 1)
+	t.Cells[0][0] = 1
+	for i := 1; i <= len(coins); i++ {
+		coin := coins[i-1]
+		t.RowLabels = append(t.RowLabels, fmt.Sprintf("+%d", coin))
+		for a := 0; a <= amount; a++ {
+			// either never use this coin, or use it at least once more
+			t.Cells[i][a] = t.Cells[i-1][a]
+			if coin <= a {
+				t.Cells[i][a] += t.Cells[i][a-coin]
+			}
+		}
+	}
+
+	return t.Cells[len(coins)][amount], t
+}
+
+// CoinWaysMemo is CoinWays as a memoized recursion over the remaining
+// amount and the denominations still allowed.
+func CoinWaysMemo(coins []int, amount int) int {
+	checkNonNegative("amount", amount)
+	checkPositive("coin", coins...)
+
+	ways := memo.Recursive2(func(self func(int, int) int, i, a int) int {
+		switch {
+		case a == 0:
+			return 1
+		case i == len(coins):
+			return 0
+		case coins[i] > a:
+			return self(i+1, a)
+		}
+		return self(i+1, a) + self(i, a-coins[i])
+	}, memo.Options{})
+
+	return ways.Call(0, amount)
+}
@@ -0,0 +1,167 @@
+package dp
+
+import (
+	"fmt"
+	"slices"
+
+	"github.com/devluxor/common-sense-guide-to-dsa/go_exercises/memo"
+)
+
+// Item is something that may go in the knapsack.
+type Item struct {
+	Weight int
+	Value  int
+}
+
+// Packing is a solution to a knapsack problem: the total value and the
+// indexes of the items packed, an index repeated once per copy in the
+// unbounded problem.
+type Packing struct {
+	Value int
+	Items []int
+}
+
+func checkItems(items []Item, capacity int) {
+	checkNonNegative("capacity", capacity)
+	for _, item := range items {
+		checkPositive("item weight", item.Weight)
+	}
+}
+
+// Knapsack packs the most valuable subset of items whose total weight does
+// not exceed capacity, taking every item at most once. Row i of the table
+// holds the best value for every capacity using only the first i items:
+// O(N·W) time and space.
+func Knapsack(items []Item, capacity int) (Packing, *Table) {
+	checkItems(items, capacity)
+
+	t := newTable(len(items)+1, capacity+1)
+	t.RowLabels = []string{"none"}
+	t.ColumnLabels = numberLabels(capacity + 1)
+	for i := 1; i <= len(items); i++ {
+		item := items[i-1]
+		t.RowLabels = append(t.RowLabels, fmt.Sprintf("w%d v%d", item.Weight, item.Value))
+
+		for w := 0; w <= capacity; w++ {
+			best := t.Cells[i-1][w]
+			if item.Weight <= w {
+				best = max(best, item.Value+t.Cells[i-1][w-item.Weight])
+			}
+			t.Cells[i][w] = best
+		}
+	}
+
+	// an item was taken wherever adding it changed the best value
+	packing := Packing{Value: t.Cells[len(items)][capacity], Items: []int{}}
+	w := capacity
+	for i := len(items); i > 0; i-- {
+		if t.Cells[i][w] != t.Cells[i-1][w] {
+			packing.Items = append(packing.Items, i-1)
+			w -= items[i-1].Weight
+		}
+	}
+	slices.Reverse(packing.Items)
+
+	return packing, t
+}
+
+// KnapsackMemo is Knapsack as a memoized recursion: the best value of the
+// items from i on is the better of skipping item i and packing it.
+func KnapsackMemo(items []Item, capacity int) Packing {
+	checkItems(items, capacity)
+
+	best := memo.Recursive2(func(self func(int, int) int, i, w int) int {
+		if i == len(items) {
+			return 0
+		}
+
+		skip := self(i+1, w)
+		if items[i].Weight > w {
+			return skip
+		}
+		return max(skip, items[i].Value+self(i+1, w-items[i].Weight))
+	}, memo.Options{})
+
+	packing := Packing{Value: best.Call(0, capacity), Items: []int{}}
+	w := capacity
+	for i := range items {
+		if best.Call(i, w) != best.Call(i+1, w) {
+			packing.Items = append(packing.Items, i)
+			w -= items[i].Weight
+		}
+	}
+
+	return packing
+}
+
+// UnboundedKnapsack is Knapsack with an unlimited supply of every item.
+// The table has a single row: the best value for every capacity, O(N·W)
+// time and O(W) space.
+func UnboundedKnapsack(items []Item, capacity int) (Packing, *Table) {
+	checkItems(items, capacity)
+
+	t := newTable(1, capacity+1)
+	t.RowLabels = []string{"value"}
+	t.ColumnLabels = numberLabels(capacity + 1)
+	best := t.Cells[0]
+	// last[w] is the item added last to reach best[w], or -1
+	last := make([]int, capacity+1)
+	last[0] = -1
+
+	for w := 1; w <= capacity; w++ {
+		best[w], last[w] = best[w-1], -1
+		for i, item := range items {
+			if item.Weight <= w && item.Value+best[w-item.Weight] > best[w] {
+				best[w], last[w] = item.Value+best[w-item.Weight], i
+			}
+		}
+	}
+
+	packing := Packing{Value: best[capacity], Items: []int{}}
+	for w := capacity; w > 0; {
+		if last[w] < 0 {
+			// the best packing leaves this unit of capacity unused
+			w--
+			continue
+		}
+		packing.Items = append(packing.Items, last[w])
+		w -= items[last[w]].Weight
+	}
+	slices.Reverse(packing.Items)
+
+	return packing, t
+}
+
+// UnboundedKnapsackMemo is UnboundedKnapsack as a memoized recursion over
+// the remaining capacity.
+func UnboundedKnapsackMemo(items []Item, capacity int) Packing {
+	checkItems(items, capacity)
+
+	best := memo.Recursive(func(self func(int) int, w int) int {
+		value := 0
+		for _, item := range items {
+			if item.Weight <= w {
+				value = max(value, item.Value+self(w-item.Weight))
+			}
+		}
+		return value
+	}, memo.Options{})
+
+	packing := Packing{Value: best.Call(capacity), Items: []int{}}
+	for w := capacity; ; {
+		next := -1
+		for i, item := range items {
+			if item.Weight <= w && best.Call(w) == item.Value+best.Call(w-item.Weight) {
+				next = i
+				break
+			}
+		}
+		if next < 0 {
+			break
+		}
+		packing.Items = append(packing.Items, next)
+		w -= items[next].Weight
+	}
+
+	return packing
+}
@@ -0,0 +1,84 @@
+package dp
+
+import (
+	"slices"
+
+	"github.com/devluxor/common-sense-guide-to-dsa/go_exercises/memo"
+)
+
+// RodCutting returns the most a rod of the given length can be sold for
+// when cut into pieces, where prices[k-1] is the price of a piece of
+// length k, and the lengths of the pieces to cut. Pieces longer than
+// len(prices) cannot be sold. The table has one row: the best revenue for
+// every length up to the rod's. O(N·L) time.
+func RodCutting(prices []int, length int) (int, []int, *Table) {
+	checkNonNegative("length", length)
+
+	t := newTable(1, length+1)
+	t.RowLabels = []string{"revenue"}
+	t.ColumnLabels = numberLabels(length + 1)
+	revenue := t.Cells[0]
+	// first[l] is the first piece to cut from a rod of length l, or 0 to
+	// leave the unit at the end unsold
+	first := make([]int, length+1)
+
+	for l := 1; l <= length; l++ {
+		revenue[l] = revenue[l-1]
+		for k := 1; k <= min(l, len(prices)); k++ {
+			if prices[k-1]+revenue[l-k] > revenue[l] {
+				revenue[l], first[l] = prices[k-1]+revenue[l-k], k
+			}
+		}
+	}
+
+	pieces := []int{}
+	for l := length; l > 0; {
+		if first[l] == 0 {
+			l--
+			continue
+		}
+		pieces = append(pieces, first[l])
+		l -= first[l]
+	}
+	slices.Sort(pieces)
+
+	return revenue[length], pieces, t
+}
+
+// RodCuttingMemo is RodCutting as a memoized recursion over the length
+// still to sell.
+func RodCuttingMemo(prices []int, length int) (int, []int) {
+	checkNonNegative("length", length)
+
+	revenue := memo.Recursive(func(self func(int) int, l int) int {
+		if l == 0 {
+			return 0
+		}
+
+		best := self(l - 1)
+		for k := 1; k <= min(l, len(prices)); k++ {
+			best = max(best, prices[k-1]+self(l-k))
+		}
+		return best
+	}, memo.Options{})
+
+	pieces := []int{}
+	for l := length; l > 0; {
+		cut := 0
+		for k := 1; k <= min(l, len(prices)); k++ {
+			if revenue.Call(l) == prices[k-1]+revenue.Call(l-k) {
+				cut = k
+				break
+			}
+		}
+		if cut == 0 {
+			l--
+			continue
+		}
+		pieces = append(pieces, cut)
+		l -= cut
+	}
+	slices.Sort(pieces)
+
+	return revenue.Call(length), pieces
+}
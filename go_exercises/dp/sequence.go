@@ -0,0 +1,316 @@
+package dp
+
+import (
+	"cmp"
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/devluxor/common-sense-guide-to-dsa/go_exercises/memo"
+)
+
+// LCS returns a longest common subsequence of a and b: the longest string
+// whose runes appear in both, in order but not necessarily next to each
+// other. Cell (i, j) of the table is the length of the LCS of the first i
+// runes of a and the first j runes of b. O(N·M) time and space.
+func LCS(a, b string) (string, *Table) {
+	ra, rb := []rune(a), []rune(b)
+
+	t := newTable(len(ra)+1, len(rb)+1)
+	t.RowLabels = runeLabels(ra)
+	t.ColumnLabels = runeLabels(rb)
+	for i := 1; i <= len(ra); i++ {
+		for j := 1; j <= len(rb); j++ {
+			if ra[i-1] == rb[j-1] {
+				t.Cells[i][j] = t.Cells[i-1][j-1] + 1
+			} else {
+				t.Cells[i][j] = max(t.Cells[i-1][j], t.Cells[i][j-1])
+			}
+		}
+	}
+
+	// walk back from the corner, collecting the runes that matched
+	common := []rune{}
+	for i, j := len(ra), len(rb); i > 0 && j > 0; {
+		switch {
+		case ra[i-1] == rb[j-1]:
+			common = append(common, ra[i-1])
+			i, j = i-1, j-1
+		case t.Cells[i-1][j] >= t.Cells[i][j-1]:
+			i--
+		default:
+			j--
+		}
+	}
+	slices.Reverse(common)
+
+	return string(common), t
+}
+
+// LCSMemo is LCS as a memoized recursion over the suffixes of a and b.
+func LCSMemo(a, b string) string {
+	ra, rb := []rune(a), []rune(b)
+
+	length := memo.Recursive2(func(self func(int, int) int, i, j int) int {
+		switch {
+		case i == len(ra) || j == len(rb):
+			return 0
+		case ra[i] == rb[j]:
+			return 1 + self(i+1, j+1)
+		}
+		return max(self(i+1, j), self(i, j+1))
+	}, memo.Options{})
+
+	common := []rune{}
+	for i, j := 0, 0; i < len(ra) && j < len(rb); {
+		switch {
+		case ra[i] == rb[j]:
+			common = append(common, ra[i])
+			i, j = i+1, j+1
+		case length.Call(i+1, j) >= length.Call(i, j+1):
+			i++
+		default:
+			j++
+		}
+	}
+
+	return string(common)
+}
+
+// Op is an edit operation of an alignment.
+type Op int
+
+const (
+	Match Op = iota
+	Substitute
+	Insert
+	Delete
+)
+
+func (op Op) String() string {
+	switch op {
+	case Match:
+		return "match"
+	case Substitute:
+		return "substitute"
+	case Insert:
+		return "insert"
+	case Delete:
+		return "delete"
+	}
+
+	return fmt.Sprintf("Op(%d)", int(op))
+}
+
+// Edit is one step of an alignment. From is the rune of the source it
+// consumes, unless it is an Insert, and To the rune of the target it
+// produces, unless it is a Delete.
+type Edit struct {
+	Op   Op
+	From rune
+	To   rune
+}
+
+// Alignment is the sequence of edits that turns one string into another.
+type Alignment []Edit
+
+// Cost returns the number of edits that are not matches.
+func (a Alignment) Cost() int {
+	cost := 0
+	for _, e := range a {
+		if e.Op != Match {
+			cost++
+		}
+	}
+
+	return cost
+}
+
+// String renders the alignment as three lines: the source with gaps for
+// insertions, a marker line with | under matches and * under
+// substitutions, and the target with gaps for deletions.
+func (a Alignment) String() string {
+	var from, marks, to strings.Builder
+	for _, e := range a {
+		switch e.Op {
+		case Match:
+			from.WriteRune(e.From)
+			marks.WriteRune('|')
+			to.WriteRune(e.To)
+		case Substitute:
+			from.WriteRune(e.From)
+			marks.WriteRune('*')
+			to.WriteRune(e.To)
+		case Insert:
+			from.WriteRune('-')
+			marks.WriteRune(' ')
+			to.WriteRune(e.To)
+		case Delete:
+			from.WriteRune(e.From)
+			marks.WriteRune(' ')
+			to.WriteRune('-')
+		}
+	}
+
+	return from.String() + "\n" + marks.String() + "\n" + to.String()
+}
+
+// EditDistance returns the Levenshtein distance between a and b, the
+// fewest insertions, deletions and substitutions of single runes that turn
+// a into b, together with one alignment that achieves it. Cell (i, j) of
+// the table is the distance between the first i runes of a and the first j
+// runes of b. O(N·M) time and space.
+func EditDistance(a, b string) (int, Alignment, *Table) {
+	ra, rb := []rune(a), []rune(b)
+
+	t := newTable(len(ra)+1, len(rb)+1)
+	t.RowLabels = runeLabels(ra)
+	t.ColumnLabels = runeLabels(rb)
+	for i := range t.Cells {
+		t.Cells[i][0] = i
+	}
+	for j := range t.Cells[0] {
+		t.Cells[0][j] = j
+	}
+	for i := 1; i <= len(ra); i++ {
+		for j := 1; j <= len(rb); j++ {
+			substitution := t.Cells[i-1][j-1]
+			if ra[i-1] != rb[j-1] {
+				substitution++
+			}
+			t.Cells[i][j] = min(substitution, t.Cells[i-1][j]+1, t.Cells[i][j-1]+1)
+		}
+	}
+
+	alignment := Alignment{}
+	for i, j := len(ra), len(rb); i > 0 || j > 0; {
+		switch {
+		case i > 0 && j > 0 && ra[i-1] == rb[j-1] && t.Cells[i][j] == t.Cells[i-1][j-1]:
+			alignment = append(alignment, Edit{Match, ra[i-1], rb[j-1]})
+			i, j = i-1, j-1
+		case i > 0 && j > 0 && t.Cells[i][j] == t.Cells[i-1][j-1]+1:
+			alignment = append(alignment, Edit{Substitute, ra[i-1], rb[j-1]})
+			i, j = i-1, j-1
+		case i > 0 && t.Cells[i][j] == t.Cells[i-1][j]+1:
+			alignment = append(alignment, Edit{Op: Delete, From: ra[i-1]})
+			i--
+		default:
+			alignment = append(alignment, Edit{Op: Insert, To: rb[j-1]})
+			j--
+		}
+	}
+	slices.Reverse(alignment)
+
+	return t.Cells[len(ra)][len(rb)], alignment, t
+}
+
+// EditDistanceMemo is EditDistance as a memoized recursion over the
+// suffixes of a and b.
+func EditDistanceMemo(a, b string) (int, Alignment) {
+	ra, rb := []rune(a), []rune(b)
+
+	distance := memo.Recursive2(func(self func(int, int) int, i, j int) int {
+		switch {
+		case i == len(ra):
+			return len(rb) - j
+		case j == len(rb):
+			return len(ra) - i
+		case ra[i] == rb[j]:
+			return self(i+1, j+1)
+		}
+		return 1 + min(self(i+1, j+1), self(i+1, j), self(i, j+1))
+	}, memo.Options{})
+
+	alignment := Alignment{}
+	for i, j := 0, 0; i < len(ra) || j < len(rb); {
+		d := distance.Call(i, j)
+		switch {
+		case i < len(ra) && j < len(rb) && ra[i] == rb[j] && d == distance.Call(i+1, j+1):
+			alignment = append(alignment, Edit{Match, ra[i], rb[j]})
+			i, j = i+1, j+1
+		case i < len(ra) && j < len(rb) && d == distance.Call(i+1, j+1)+1:
+			alignment = append(alignment, Edit{Substitute, ra[i], rb[j]})
+			i, j = i+1, j+1
+		case i < len(ra) && d == distance.Call(i+1, j)+1:
+			alignment = append(alignment, Edit{Op: Delete, From: ra[i]})
+			i++
+		default:
+			alignment = append(alignment, Edit{Op: Insert, To: rb[j]})
+			j++
+		}
+	}
+
+	return distance.Call(0, 0), alignment
+}
+
+// LIS returns a longest strictly increasing subsequence of s. The table
+// has one row: for every index, the length of the longest increasing
+// subsequence that ends there. O(N²) time.
+func LIS[T cmp.Ordered](s []T) ([]T, *Table) {
+	t := newTable(1, len(s))
+	t.RowLabels = []string{"length"}
+	for _, v := range s {
+		t.ColumnLabels = append(t.ColumnLabels, fmt.Sprint(v))
+	}
+	length := t.Cells[0]
+	// previous[i] is the index before i in the subsequence ending at i
+	previous := make([]int, len(s))
+
+	end := -1
+	for i := range s {
+		length[i], previous[i] = 1, -1
+		for j := range i {
+			if s[j] < s[i] && length[j]+1 > length[i] {
+				length[i], previous[i] = length[j]+1, j
+			}
+		}
+		if end < 0 || length[i] > length[end] {
+			end = i
+		}
+	}
+
+	subsequence := []T{}
+	for i := end; i >= 0; i = previous[i] {
+		subsequence = append(subsequence, s[i])
+	}
+	slices.Reverse(subsequence)
+
+	return subsequence, t
+}
+
+// LISMemo is LIS as a memoized recursion: the longest increasing
+// subsequence starting at i extends the longest one starting at any later,
+// greater value.
+func LISMemo[T cmp.Ordered](s []T) []T {
+	length := memo.Recursive(func(self func(int) int, i int) int {
+		best := 1
+		for j := i + 1; j < len(s); j++ {
+			if s[j] > s[i] {
+				best = max(best, 1+self(j))
+			}
+		}
+		return best
+	}, memo.Options{})
+
+	start := -1
+	for i := range s {
+		if start < 0 || length.Call(i) > length.Call(start) {
+			start = i
+		}
+	}
+
+	subsequence := []T{}
+	for i := start; i >= 0; {
+		subsequence = append(subsequence, s[i])
+		next := -1
+		for j := i + 1; j < len(s); j++ {
+			if s[j] > s[i] && length.Call(j) == length.Call(i)-1 {
+				next = j
+				break
+			}
+		}
+		i = next
+	}
+
+	return subsequence
+}
@@ -0,0 +1,101 @@
+// Package dp solves the classic dynamic programming problems twice: top
+// down, as a memoized recursion over subproblems, and bottom up, filling a
+// table from the smallest subproblems to the largest. The bottom-up
+// functions also return their table, which can be printed to follow how
+// the answer was built.
+//
+// Functions panic on weights, coins or lengths that are not positive and
+// on negative capacities or amounts, as those make no sense for the
+// problem.
+package dp
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"text/tabwriter"
+)
+
+// Table is the table a bottom-up solution filled in. Cells[i][j] is the
+// answer to the subproblem labelled RowLabels[i] and ColumnLabels[j].
+type Table struct {
+	Cells        [][]int
+	RowLabels    []string
+	ColumnLabels []string
+}
+
+// newTable returns a rows × columns table of zeros.
+func newTable(rows, columns int) *Table {
+	t := &Table{Cells: make([][]int, rows)}
+	for i := range t.Cells {
+		t.Cells[i] = make([]int, columns)
+	}
+
+	return t
+}
+
+// At returns the cell in row i and column j.
+func (t *Table) At(i, j int) int {
+	return t.Cells[i][j]
+}
+
+// String renders the table with its labels, aligned in columns.
+func (t *Table) String() string {
+	var b strings.Builder
+	w := tabwriter.NewWriter(&b, 0, 0, 1, ' ', tabwriter.AlignRight)
+
+	fmt.Fprint(w, "\t")
+	for _, label := range t.ColumnLabels {
+		fmt.Fprintf(w, "%s\t", label)
+	}
+	fmt.Fprintln(w)
+
+	for i, row := range t.Cells {
+		if i < len(t.RowLabels) {
+			fmt.Fprint(w, t.RowLabels[i])
+		}
+		fmt.Fprint(w, "\t")
+		for _, cell := range row {
+			fmt.Fprintf(w, "%d\t", cell)
+		}
+		fmt.Fprintln(w)
+	}
+
+	w.Flush()
+	return b.String()
+}
+
+// numberLabels returns the labels "0" to "n-1".
+func numberLabels(n int) []string {
+	labels := make([]string, n)
+	for i := range labels {
+		labels[i] = strconv.Itoa(i)
+	}
+
+	return labels
+}
+
+// runeLabels labels the rows or columns of a table over the prefixes of
+// s: the empty prefix first, then one label per rune.
+func runeLabels(s []rune) []string {
+	labels := []string{"ε"}
+	for _, r := range s {
+		labels = append(labels, string(r))
+	}
+
+	return labels
+}
+
+func checkPositive(what string, values ...int) {
+	for _, v := range values {
+		if v <= 0 {
+			panic(fmt.Sprintf("dp: %s must be positive, got %d", what, v))
+		}
+	}
+}
+
+func checkNonNegative(what string, v int) {
+	if v < 0 {
+		panic(fmt.Sprintf("dp: %s must not be negative, got %d", what, v))
+	}
+}
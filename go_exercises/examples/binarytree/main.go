@@ -0,0 +1,26 @@
+package main
+
+import (
+	"fmt"
+	"slices"
+
+	"github.com/devluxor/common-sense-guide-to-dsa/go_exercises/tree"
+)
+
+func main() {
+	root := tree.NewNode(3)
+	root.Insert(3)
+	root.Insert(2)
+	root.Insert(4)
+	root.Insert(1)
+	root.Insert(900)
+	// fmt.Println(root.Search(5))
+	// fmt.Println(root.Greatest())
+	for d := range root.All() {
+		fmt.Println(d)
+	}
+
+	fmt.Println(slices.Collect(root.PreOrder()))
+	fmt.Println(slices.Collect(root.PostOrder()))
+	fmt.Println(slices.Collect(root.LevelOrder()))
+}
@@ -0,0 +1,31 @@
+package main
+
+import (
+	"fmt"
+
+	"github.com/devluxor/common-sense-guide-to-dsa/go_exercises/cache"
+)
+
+func main() {
+	evicted := func(key string, value int) {
+		fmt.Println("evicted", key, value)
+	}
+
+	lru := cache.NewLRU(2, evicted)
+	lru.Put("a", 1)
+	lru.Put("b", 2)
+	lru.Get("a")
+	lru.Put("c", 3) // evicts b, the least recently used
+	fmt.Println(lru.Get("b"))
+	fmt.Printf("%+v\n", lru.Stats())
+
+	lfu := cache.NewLFU(2, evicted)
+	lfu.Put("a", 1)
+	lfu.Put("b", 2)
+	lfu.Get("a")
+	lfu.Get("a")
+	lfu.Get("b")
+	lfu.Put("c", 3) // evicts b, used fewer times than a
+	fmt.Println(lfu.Peek("a"))
+	fmt.Printf("%+v %.2f\n", lfu.Stats(), lfu.Stats().HitRate())
+}
@@ -0,0 +1,37 @@
+package main
+
+import (
+	"fmt"
+
+	"github.com/devluxor/common-sense-guide-to-dsa/go_exercises/combinatorics"
+)
+
+func main() {
+	// Let's say you have a grid of rows and columns. Write a function that
+	// accepts a number of rows and a number of columns, and calculates the
+	// number of possible "shortest" paths from the upper-leftmost square
+	// to the lower-rightmost square.
+	fmt.Println(combinatorics.UniquePaths(7, 3))
+	fmt.Println(combinatorics.UniquePaths(35, 35))
+
+	if _, err := combinatorics.ToInt(combinatorics.UniquePaths(40, 40)); err != nil {
+		fmt.Println(err)
+	}
+
+	// the same grid with the middle cell blocked
+	blocked := [][]bool{
+		{false, false, false},
+		{false, true, false},
+		{false, false, false},
+	}
+	fmt.Println(combinatorics.UniquePathsObstacles(blocked))
+
+	fmt.Println(combinatorics.Binomial(52, 5))
+	for n := range 10 {
+		fmt.Print(combinatorics.Catalan(n), " ")
+	}
+	fmt.Println()
+
+	fmt.Println(combinatorics.Staircase(11, []int{1, 2, 3}))
+	fmt.Println(combinatorics.Staircase(100, []int{1, 2}))
+}
@@ -0,0 +1,33 @@
+package main
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/devluxor/common-sense-guide-to-dsa/go_exercises/counter"
+)
+
+func main() {
+	text := "the quick brown fox jumps over the lazy dog the end"
+	words := counter.New(strings.Fields(text)...)
+
+	fmt.Println("distinct:", words.Len(), "total:", words.Total())
+	fmt.Println("the:", words.Count("the"))
+	fmt.Println("most common:", words.MostCommon(2))
+
+	letters := counter.New([]rune("mississippi")...)
+	for letter, count := range letters.All() {
+		fmt.Printf("%c=%d ", letter, count)
+	}
+	fmt.Println()
+
+	other := counter.New([]rune("pipes")...)
+	show := func(label string, c *counter.Counter[rune]) {
+		fmt.Printf("%-13s %s\n", label, string(c.Elements()))
+	}
+	show("union:", letters.Union(other))
+	show("intersection:", letters.Intersection(other))
+	show("difference:", letters.Difference(other))
+	show("sum:", letters.Sum(other))
+	fmt.Println("sorted:", string(counter.SortedElements(letters)))
+}
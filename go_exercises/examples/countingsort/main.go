@@ -0,0 +1,36 @@
+package main
+
+import (
+	"fmt"
+
+	"github.com/devluxor/common-sense-guide-to-dsa/go_exercises/sorting"
+)
+
+/*
+You are to write a function that sorts these readings from lowest to highest.
+*/
+
+type reading struct {
+	city        string
+	temperature int // in tenths of a degree Fahrenheit
+}
+
+func main() {
+	a := []int{986, 980, 971, 990, 989, 978, 985, 982, 980, 971}
+	fmt.Println(sorting.CountingSortRange(a, 970, 990))
+
+	// a reading outside the range is reported instead of silently dropped
+	fmt.Println(sorting.CountingSortRange([]int{986, 1004}, 970, 990))
+
+	readings := []reading{{"Oslo", 975}, {"Lima", 982}, {"Rome", 975}, {"Pune", 990}}
+	sorted, _ := sorting.CountingSortBy(readings, func(r reading) int { return r.temperature })
+	fmt.Println(sorted)
+
+	b := []int{170, 45, 75, -90, 802, 24, 2, 66}
+	sorting.RadixSort(b)
+	fmt.Println(b)
+
+	words := []string{"dab", "cab", "fad", "bad", "dad", "ebb", "ace", "add", "fed", "bed", "fee", "bee", "be"}
+	sorting.RadixSortStrings(words)
+	fmt.Println(words)
+}
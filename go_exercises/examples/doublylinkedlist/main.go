@@ -0,0 +1,31 @@
+package main
+
+import (
+	"fmt"
+	"slices"
+
+	"github.com/devluxor/common-sense-guide-to-dsa/go_exercises/list"
+)
+
+func main() {
+	var l list.DoublyList[int]
+	i := 1
+	for i <= 10 {
+		l.PushBack(i)
+		i++
+	}
+
+	fmt.Println(slices.Collect(l.All()))
+	fmt.Println(slices.Collect(l.Backward()))
+
+	// every insertion returns a handle for the O(1) operations
+	zero := l.PushFront(0)
+	eleven := l.InsertAfter(11, l.Tail())
+	l.MoveToBack(zero)
+	l.MoveToFront(eleven)
+	l.Remove(l.Head().Next())
+	fmt.Println(slices.Collect(l.All()), l.Len())
+
+	l.Splice(list.NewDoubly(20, 21, 22))
+	fmt.Println(slices.Collect(l.All()), l.Len())
+}
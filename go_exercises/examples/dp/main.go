@@ -0,0 +1,37 @@
+package main
+
+import (
+	"fmt"
+
+	"github.com/devluxor/common-sense-guide-to-dsa/go_exercises/dp"
+)
+
+func main() {
+	items := []dp.Item{{Weight: 1, Value: 1}, {Weight: 3, Value: 4}, {Weight: 4, Value: 5}, {Weight: 5, Value: 7}}
+	packing, table := dp.Knapsack(items, 7)
+	fmt.Printf("knapsack: %+v\n%s\n", packing, table)
+	fmt.Printf("memoized: %+v\n", dp.KnapsackMemo(items, 7))
+	unbounded, _ := dp.UnboundedKnapsack(items, 7)
+	fmt.Printf("unbounded: %+v\n\n", unbounded)
+
+	coins := []int{1, 5, 10, 25}
+	used, ok, _ := dp.MinCoins(coins, 63)
+	ways, _ := dp.CoinWays(coins, 63)
+	fmt.Println("63 cents:", used, ok, "in", ways, "ways")
+	_, ok = dp.MinCoinsMemo([]int{4, 6}, 7)
+	fmt.Println("7 from 4 and 6:", ok)
+	fmt.Println()
+
+	lcs, table := dp.LCS("ABCBDAB", "BDCABA")
+	fmt.Printf("LCS: %s\n%s\n", lcs, table)
+
+	distance, alignment, _ := dp.EditDistance("kitten", "sitting")
+	fmt.Printf("edit distance: %d\n%s\n\n", distance, alignment)
+
+	subsequence, table := dp.LIS([]int{10, 9, 2, 5, 3, 7, 101, 18})
+	fmt.Printf("LIS: %v\n%s\n", subsequence, table)
+
+	revenue, pieces, _ := dp.RodCutting([]int{1, 5, 8, 9, 10, 17, 17, 20}, 8)
+	fmt.Println("rod of 8:", revenue, pieces)
+	fmt.Println(dp.RodCuttingMemo([]int{1, 5, 8, 9, 10, 17, 17, 20}, 8))
+}
@@ -0,0 +1,62 @@
+package main
+
+import (
+	"fmt"
+	"slices"
+
+	"github.com/devluxor/common-sense-guide-to-dsa/go_exercises/hashing"
+)
+
+func main() {
+	fmt.Println(hashing.TwoSum([]int{2, 0, 4, 1, 7}, 10))
+
+	fmt.Println(hashing.FirstDuplicate([]string{"a", "b", "b", "c", "c"}))
+
+	fmt.Println(hashing.LongestSequence([]int{119, 13, 15, 12, 18, 14, 17, 11}))
+
+	// Write a function that returns the first non-duplicated character in a
+	// string. For example, "minimum" has two characters that only exist
+	// once, the "n" and the "u", so your function should return the "n".
+	fmt.Println(hashing.FirstUnique("minimum", hashing.UniqueOptions{}))
+	fmt.Println(hashing.AllUnique("Minimum", hashing.UniqueOptions{FoldCase: true}))
+
+	// Write a function that accepts a string that contains all the letters
+	// of the alphabet except one and returns the missing letter.
+	missing, err := hashing.MissingFrom(hashing.EnglishAlphabet,
+		"The quick brown box jumps over a lazy dog", hashing.MissingOptions{FoldCase: true})
+	fmt.Println(string(missing), err)
+
+	numbers := []int{3, 4, 1, 7}
+	fmt.Println(hashing.MissingInRange(numbers, slices.Min(numbers), slices.Max(numbers)))
+
+	var stream hashing.UniqueStream
+	for _, r := range "minimum" {
+		stream.Add(r)
+		first, _ := stream.First()
+		fmt.Printf("%c -> %s\n", r, first.Text)
+	}
+
+	// You are to write a function that accepts two arrays of players and
+	// returns an array of the players who play in both sports.
+	basketballPlayers := []map[string]string{
+		{"first_name": "Jill", "last_name": "Huang", "team": "Gators"},
+		{"first_name": "Janko", "last_name": "Barton", "team": "Sharks"},
+		{"first_name": "Wanda", "last_name": "Vakulskas", "team": "Sharks"},
+		{"first_name": "Jill", "last_name": "Moloney", "team": "Gators"},
+		{"first_name": "Luuk", "last_name": "Watkins", "team": "Gators"}}
+
+	footballPlayers := []map[string]string{
+		{"first_name": "Hanzla", "last_name": "Radosti", "team": "32ers"},
+		{"first_name": "Tina", "last_name": "Watkins", "team": "Barleycorns"},
+		{"first_name": "Alex", "last_name": "Patel", "team": "32ers"},
+		{"first_name": "Jill", "last_name": "Huang", "team": "Barleycorns"},
+		{"first_name": "Wanda", "last_name": "Vakulskas", "team": "Barleycorns"}}
+
+	for _, p := range hashing.Common(basketballPlayers, footballPlayers, parseName) {
+		fmt.Println(parseName(p))
+	}
+}
+
+func parseName(player map[string]string) string {
+	return fmt.Sprintf("%s %s", player["first_name"], player["last_name"])
+}
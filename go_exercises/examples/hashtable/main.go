@@ -0,0 +1,86 @@
+package main
+
+import (
+	"fmt"
+	"math/rand/v2"
+	"time"
+
+	"github.com/devluxor/common-sense-guide-to-dsa/go_exercises/hashtable"
+)
+
+const n = 200_000
+
+func main() {
+	distributions := []struct {
+		name string
+		keys []int
+	}{
+		{"sequential", keys(func(i int) int { return i })},
+		{"random", keys(func(int) int { return rand.Int() })},
+		{"multiples of 1024", keys(func(i int) int { return i * 1024 })},
+	}
+
+	hashes := []struct {
+		name string
+		hash hashtable.HashFunc[int]
+	}{
+		{"identity", hashtable.Identity[int]},
+		{"splitmix", hashtable.IntegerHash[int]()},
+	}
+
+	for _, d := range distributions {
+		fmt.Printf("%s keys\n", d.name)
+		fmt.Printf("  %-8s %-14s %s\n", "map", "", measure(d.keys, nil))
+
+		for _, h := range hashes {
+			opts := hashtable.Options[int]{Hash: h.hash}
+			tables := []struct {
+				name  string
+				table hashtable.HashTable[int, int]
+			}{
+				{"chained", hashtable.NewChained[int, int](opts)},
+				{"linear", hashtable.NewLinearProbing[int, int](opts)},
+				{"robin", hashtable.NewRobinHood[int, int](opts)},
+			}
+
+			for _, t := range tables {
+				elapsed := measure(d.keys, t.table)
+				m := t.table.Metrics()
+				fmt.Printf("  %-8s %-14s %-10v collisions=%-6d probe avg=%.2f max=%d\n",
+					h.name, t.name, elapsed, m.Collisions, m.AverageProbeLength, m.MaxProbeLength)
+			}
+		}
+	}
+}
+
+func keys(key func(i int) int) []int {
+	k := make([]int, n)
+	for i := range k {
+		k[i] = key(i)
+	}
+	return k
+}
+
+// measure inserts and then looks up every key, in t or, if t is nil, in a
+// builtin map.
+func measure(keys []int, t hashtable.HashTable[int, int]) time.Duration {
+	start := time.Now()
+	if t == nil {
+		m := map[int]int{}
+		for i, k := range keys {
+			m[k] = i
+		}
+		for _, k := range keys {
+			_ = m[k]
+		}
+	} else {
+		for i, k := range keys {
+			t.Put(k, i)
+		}
+		for _, k := range keys {
+			t.Get(k)
+		}
+	}
+
+	return time.Since(start).Round(time.Microsecond)
+}
@@ -0,0 +1,40 @@
+package main
+
+import (
+	"fmt"
+	"slices"
+
+	"github.com/devluxor/common-sense-guide-to-dsa/go_exercises/list"
+)
+
+func main() {
+	l := list.New(1, 1, 1, 2, 1, 3, 1, 4)
+
+	fmt.Println(slices.Collect(l.All()))
+	// fmt.Println(l.Last())
+	// fmt.Println(l.LastNoTail())
+	// list.Remove(l, 2)
+	// l.Reverse()
+
+	list.RemoveDuplicates(l)
+
+	fmt.Println(slices.Collect(l.All()), l.Len())
+
+	// remove duplicates with space complexity of 1, on a sorted list
+	sorted := list.New(1, 1, 2, 3, 3, 3, 4)
+	list.DedupeSorted(sorted)
+	fmt.Println(slices.Collect(sorted.All()))
+
+	merged := list.Merge(sorted, list.New(0, 2, 5))
+	fmt.Println(slices.Collect(merged.All()), merged.Middle().Data)
+	fmt.Println(merged.KthFromEnd(2))
+
+	list.Partition(merged, 3)
+	fmt.Println(slices.Collect(merged.All()))
+
+	// a loose chain of nodes whose last node points back to the second one
+	head := &list.Node[int]{Data: 1}
+	second := head.Link(&list.Node[int]{Data: 2})
+	second.Link(&list.Node[int]{Data: 3}).Link(second)
+	fmt.Println(list.HasCycle(head), list.CycleStart(head).Data)
+}
@@ -0,0 +1,45 @@
+package main
+
+import (
+	"fmt"
+
+	"github.com/devluxor/common-sense-guide-to-dsa/go_exercises/memo"
+)
+
+func main() {
+	// The following function uses recursion to calculate the Nth number
+	// from the Golomb sequence. It's terribly inefficient, though! Use
+	// memoization to optimize it.
+	golomb := memo.Recursive(func(self func(int) int, n int) int {
+		if n == 1 {
+			return 1
+		}
+		return 1 + self(n-self(self(n-1)))
+	}, memo.Options{})
+
+	fmt.Println(golomb.Call(9), golomb.Call(1000))
+	fmt.Printf("%+v\n", golomb.Stats())
+
+	// Here is a solution to the "Unique Paths" problem. Use memoization to
+	// improve its efficiency.
+	uniquePaths := memo.Recursive2(func(self func(int, int) int, rows, columns int) int {
+		if rows == 1 || columns == 1 {
+			return 1
+		}
+		return self(rows-1, columns) + self(rows, columns-1)
+	}, memo.Options{})
+
+	fmt.Println(uniquePaths.Call(7, 3), uniquePaths.Call(20, 20))
+	fmt.Printf("%+v %.2f\n", uniquePaths.Stats(), uniquePaths.Stats().HitRate())
+
+	// a bounded memo keeps memory flat at the cost of recomputing
+	bounded := memo.Recursive2(func(self func(int, int) int, rows, columns int) int {
+		if rows == 1 || columns == 1 {
+			return 1
+		}
+		return self(rows-1, columns) + self(rows, columns-1)
+	}, memo.Options{MaxEntries: 32, Eviction: memo.LRU})
+
+	fmt.Println(bounded.Call(20, 20), bounded.Len())
+	fmt.Printf("%+v\n", bounded.Stats())
+}
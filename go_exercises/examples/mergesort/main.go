@@ -0,0 +1,36 @@
+package main
+
+import (
+	"cmp"
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/devluxor/common-sense-guide-to-dsa/go_exercises/extsort"
+	"github.com/devluxor/common-sense-guide-to-dsa/go_exercises/sorting"
+)
+
+type player struct {
+	name  string
+	score int
+}
+
+func main() {
+	players := []player{{"Jill", 3}, {"Janko", 1}, {"Wanda", 3}, {"Luuk", 1}}
+
+	// merge sort is stable: players with the same score keep their order
+	sorting.MergeSortFunc(players, func(a, b player) int {
+		return cmp.Compare(a.score, b.score)
+	})
+	fmt.Println(players)
+
+	n := []int{5, 2, 4, 6, 1, 3}
+	sorting.MergeSortBottomUp(n)
+	fmt.Println(n)
+
+	// a tiny memory budget forces the records through temporary files
+	input := strings.NewReader("pear\napple\nfig\nbanana\ncherry\n")
+	if err := extsort.Sort(input, os.Stdout, extsort.Options{MemoryLimit: 40}); err != nil {
+		fmt.Println(err)
+	}
+}
@@ -0,0 +1,48 @@
+package main
+
+import (
+	"fmt"
+
+	"github.com/devluxor/common-sense-guide-to-dsa/go_exercises/tree"
+)
+
+func main() {
+	t := tree.NewBST[int, string]()
+	for _, k := range []int{50, 25, 75, 10, 33, 56, 89, 4, 11, 30, 40, 52, 61, 82, 95} {
+		t.Put(k, fmt.Sprint("value ", k))
+	}
+
+	fmt.Println(t.Get(61))
+	fmt.Println(t.Floor(60))
+	fmt.Println(t.Ceiling(60))
+	fmt.Println(t.Rank(56), t.Len())
+
+	// deleting a node with two children promotes its successor
+	t.Delete(50)
+	fmt.Println(t.Select(7))
+	fmt.Println(t.Min())
+	fmt.Println(t.Max())
+
+	// the three smallest and the three greatest keys
+	for k, v := range t.All() {
+		if k > 11 {
+			break
+		}
+		fmt.Println(k, v)
+	}
+	for k := range t.Backward() {
+		if k < 82 {
+			break
+		}
+		fmt.Println(k)
+	}
+
+	// sorted input is the worst case for a plain BST but not for the
+	// self-balancing variants
+	for _, m := range []tree.OrderedMap[int, int]{tree.NewAVL[int, int](), tree.NewRedBlack[int, int]()} {
+		for i := range 1000 {
+			m.Put(i, i*i)
+		}
+		fmt.Println(m.Get(999))
+	}
+}
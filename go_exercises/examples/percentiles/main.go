@@ -0,0 +1,24 @@
+package main
+
+import (
+	"fmt"
+	"math/rand"
+
+	"github.com/devluxor/common-sense-guide-to-dsa/go_exercises/sorting"
+)
+
+func main() {
+	// simulated request latencies, in milliseconds
+	latencies := make([]float64, 100000)
+	for i := range latencies {
+		latencies[i] = rand.ExpFloat64() * 20
+	}
+
+	median, _ := sorting.Median(latencies)
+	p99, _ := sorting.Percentile(latencies, 99)
+	fmt.Printf("median %.1fms, p99 %.1fms\n", median, p99)
+	fmt.Printf("slowest: %.1f\n", sorting.TopK(latencies, 3))
+
+	a := []int{0, 50, 20, 10, 60, 30}
+	fmt.Println(sorting.Select(a, 1))
+}
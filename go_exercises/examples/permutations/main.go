@@ -0,0 +1,59 @@
+package main
+
+import (
+	"fmt"
+
+	"github.com/devluxor/common-sense-guide-to-dsa/go_exercises/combinatorics"
+)
+
+func main() {
+	letters := []string{"a", "b", "c"}
+
+	for p := range combinatorics.Permutations(letters, 3) {
+		fmt.Print(p, " ")
+	}
+	fmt.Println()
+
+	for p := range combinatorics.Permutations(letters, 2) {
+		fmt.Print(p, " ")
+	}
+	fmt.Println()
+
+	for p := range combinatorics.PermutationsWithRepetition([]int{0, 1}, 3) {
+		fmt.Print(p, " ")
+	}
+	fmt.Println()
+
+	for c := range combinatorics.Combinations([]int{1, 2, 3, 4}, 2) {
+		fmt.Print(c, " ")
+	}
+	fmt.Println()
+
+	for s := range combinatorics.PowerSet(letters) {
+		fmt.Print(s, " ")
+	}
+	fmt.Println()
+
+	// Anagram generation from the recursion chapter, without duplicates
+	// for repeated letters
+	for a := range combinatorics.Anagrams("abca") {
+		fmt.Print(a, " ")
+	}
+	fmt.Println()
+
+	// "permutations" has 12! / 2! = 239 500 800 distinct anagrams; only
+	// the ones looked at are generated
+	count := 0
+	for a := range combinatorics.Anagrams("permutations") {
+		if count == 5 {
+			break
+		}
+		fmt.Print(a, " ")
+		count++
+	}
+	fmt.Println()
+
+	digits := []int{1, 2, 3, 4}
+	combinatorics.NextPermutation(digits)
+	fmt.Println(digits)
+}
@@ -0,0 +1,28 @@
+package main
+
+import (
+	"fmt"
+	"slices"
+
+	"github.com/devluxor/common-sense-guide-to-dsa/go_exercises/queue"
+)
+
+func main() {
+	// a printer queue: documents come out in the order they were sent
+	q := queue.New[string]()
+	q.Enqueue("first document")
+	q.Enqueue("second document")
+	q.Enqueue("third document")
+
+	for doc, ok := q.Dequeue(); ok; doc, ok = q.Dequeue() {
+		fmt.Println("printing", doc)
+	}
+
+	var d queue.Deque[int]
+	d.PushBack(2)
+	d.PushBack(3)
+	d.PushFront(1)
+	fmt.Println(slices.Collect(d.All()), slices.Collect(d.Backward()))
+	fmt.Println(d.PopBack())
+	fmt.Println(d.PopFront())
+}
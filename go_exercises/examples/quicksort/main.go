@@ -0,0 +1,24 @@
+package main
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/devluxor/common-sense-guide-to-dsa/go_exercises/sorting"
+)
+
+func main() {
+	a := []int{7, 8, 1, 5}
+	sorting.Quicksort(a)
+	fmt.Println(a)
+
+	b := []int{3, 1, 3, 3, 9, 0, 3, 2, 3, 1, 3}
+	sorting.Sort(b)
+	fmt.Println(b)
+
+	words := []string{"banana", "Apple", "cherry", "apple"}
+	sorting.SortFunc(words, func(x, y string) int {
+		return strings.Compare(strings.ToLower(x), strings.ToLower(y))
+	})
+	fmt.Println(words)
+}
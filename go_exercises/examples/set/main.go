@@ -0,0 +1,25 @@
+package main
+
+import (
+	"fmt"
+
+	"github.com/devluxor/common-sense-guide-to-dsa/go_exercises/set"
+)
+
+func main() {
+	// Write a function that returns the intersection of two arrays. Arrays
+	// can have repeated values, different lengths and are not sorted.
+	a := []int{1, 1, 1, 5}
+	b := []int{2, 3, 1, 1}
+	fmt.Println("as sets:", set.Intersect(a, b, set.Distinct))
+	fmt.Println("as bags:", set.Intersect(a, b, set.Bag))
+
+	odd := set.New(1, 3, 5, 7, 9)
+	prime := set.New(2, 3, 5, 7)
+	fmt.Println("union:               ", odd.Union(prime).Items())
+	fmt.Println("intersection:        ", odd.Intersection(prime).Items())
+	fmt.Println("difference:          ", odd.Difference(prime).Items())
+	fmt.Println("symmetric difference:", odd.SymmetricDifference(prime).Items())
+	fmt.Println("{3, 5} is a subset of the odds:", set.New(3, 5).IsSubset(odd))
+	fmt.Println("odds and evens are disjoint:", odd.IsDisjoint(set.New(2, 4, 6)))
+}
@@ -0,0 +1,30 @@
+package main
+
+import (
+	"fmt"
+
+	"github.com/devluxor/common-sense-guide-to-dsa/go_exercises/stack"
+)
+
+/*
+Write a function that uses a stack to reverse a string. (For example, "abcde"
+would become "edcba".)
+*/
+
+func main() {
+	fmt.Println(reverse("abcde"))
+}
+
+func reverse(sentence string) string {
+	s := stack.New[rune]()
+	for _, runeChar := range sentence {
+		s.Push(runeChar)
+	}
+
+	r := ""
+	for c, ok := s.Pop(); ok; c, ok = s.Pop() {
+		r += string(c)
+	}
+
+	return r
+}
@@ -0,0 +1,61 @@
+package main
+
+import (
+	"fmt"
+	"math"
+
+	"github.com/devluxor/common-sense-guide-to-dsa/go_exercises/hashing"
+	"github.com/devluxor/common-sense-guide-to-dsa/go_exercises/list"
+	"github.com/devluxor/common-sense-guide-to-dsa/go_exercises/sorting"
+	"github.com/devluxor/common-sense-guide-to-dsa/go_exercises/steps"
+	"github.com/devluxor/common-sense-guide-to-dsa/go_exercises/tree"
+)
+
+func main() {
+	var c steps.Counter
+
+	// binary search on an ordered array of 1,000,000 items never needs
+	// more than log2(N) + 1 comparisons: 20
+	sorted := make([]int, 1_000_000)
+	for i := range sorted {
+		sorted[i] = 2 * i
+	}
+	worst := 0
+	for _, target := range []int{-1, 0, 999_999, 1_234_567, 1_999_998, 2_000_000} {
+		c.Reset()
+		sorting.BinarySearchCounted(sorted, target, &c)
+		worst = max(worst, c.Comparisons)
+	}
+	fmt.Println("binary search:", worst, "<=", int(math.Log2(float64(len(sorted))))+1)
+
+	c.Reset()
+	numbers := []int{7, 8, 1, 5, 3, 9, 2, 6, 4}
+	sorting.QuicksortCounted(numbers, &c)
+	fmt.Println("quicksort:", &c)
+
+	c.Reset()
+	bst := tree.NewBST[int, int]()
+	bst.SetCounter(&c)
+	for i := range 1000 {
+		bst.Put(i, i)
+	}
+	fmt.Println("sorted inserts into a plain BST:", c.Comparisons)
+
+	c.Reset()
+	avl := tree.NewAVL[int, int]()
+	avl.SetCounter(&c)
+	for i := range 1000 {
+		avl.Put(i, i)
+	}
+	fmt.Println("sorted inserts into an AVL tree:", c.Comparisons)
+
+	c.Reset()
+	l := list.New(1, 2, 3, 4, 5, 6, 7, 8)
+	l.SetCounter(&c)
+	l.LastNoTail()
+	fmt.Println("last value without a tail pointer:", c.Reads, "reads")
+
+	c.Reset()
+	hashing.LongestSequenceCounted([]int{119, 13, 15, 12, 18, 14, 17, 11}, &c)
+	fmt.Println("longest sequence:", c.HashLookups, "hash lookups")
+}
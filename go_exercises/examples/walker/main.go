@@ -0,0 +1,36 @@
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"github.com/devluxor/common-sense-guide-to-dsa/go_exercises/walker"
+)
+
+func main() {
+	// Here is an array containing both numbers as well as other arrays,
+	// which in turn contain numbers and arrays. Write a recursive function
+	// that prints all the numbers (and just numbers).
+	array := []any{
+		1, 2, 3,
+		[]any{4, 5, 6},
+		7,
+		[]any{8, []any{9, 10, 11, []any{12, 13, 14}}},
+		[]any{15, 16, 17, 18, 19, []any{20, 21, 22, []any{23, 24, 25, []any{26, 27, 29}}, 30, 31}, 32},
+		33,
+		"not a number",
+	}
+
+	for _, n := range walker.Of[int](array, walker.Options{}) {
+		fmt.Print(n, " ")
+	}
+	fmt.Println()
+
+	fmt.Println(walker.Flatten(array, walker.Options{MaxDepth: 1})[3:6])
+
+	var document any
+	json.Unmarshal([]byte(`{"name": "gizmo", "sizes": [1, 2.5, {"custom": true}], "tags": null}`), &document)
+	for leaf := range walker.Walk(document, walker.Options{Iterative: true}) {
+		fmt.Printf("%-22s %v\n", leaf.Path, leaf.Value)
+	}
+}
@@ -0,0 +1,318 @@
+// Package extsort sorts newline-delimited records that may not fit in
+// memory. Records are read into runs no larger than a memory budget; each
+// run is sorted with a stable merge sort and spilled to a temporary file,
+// and the runs are then merged k ways into the output. When there are more
+// runs than files that may be open at once, groups of runs are first
+// merged into longer runs, in as many passes as needed.
+package extsort
+
+import (
+	"bufio"
+	"container/heap"
+	"io"
+	"os"
+	"strings"
+
+	"github.com/devluxor/common-sense-guide-to-dsa/go_exercises/sorting"
+)
+
+// DefaultMemoryLimit is used when Options.MemoryLimit is not positive.
+const DefaultMemoryLimit = 64 << 20
+
+// DefaultMaxOpenRuns is used when Options.MaxOpenRuns is not positive.
+const DefaultMaxOpenRuns = 64
+
+// recordOverhead approximates the memory a record costs beyond its bytes:
+// the string header kept in the run slice.
+const recordOverhead = 16
+
+// Options configures Sort. The zero value sorts lines in byte order with
+// DefaultMemoryLimit, spilling to the default temporary directory.
+type Options struct {
+	// MemoryLimit bounds the bytes of records held in memory at once.
+	MemoryLimit int64
+	// TempDir is where runs are spilled; empty means os.TempDir().
+	TempDir string
+	// Compare orders records; nil means strings.Compare.
+	Compare func(a, b string) int
+	// MaxOpenRuns bounds how many run files a merge reads at once, which
+	// keeps Sort within the process's open file limit. Values below 2 are
+	// raised to 2.
+	MaxOpenRuns int
+}
+
+// Sort reads newline-delimited records from r and writes them to w in
+// sorted order, one per line. The sort is stable: records that compare
+// equal keep their input order. A missing newline after the last record is
+// tolerated, and every output record ends with one.
+func Sort(r io.Reader, w io.Writer, opts Options) (err error) {
+	limit := opts.MemoryLimit
+	if limit <= 0 {
+		limit = DefaultMemoryLimit
+	}
+	compare := opts.Compare
+	if compare == nil {
+		compare = strings.Compare
+	}
+
+	fanIn := opts.MaxOpenRuns
+	if fanIn <= 0 {
+		fanIn = DefaultMaxOpenRuns
+	}
+	fanIn = max(fanIn, 2)
+
+	// runs holds the names of the sorted runs on disk, oldest first; every
+	// temporary file created is removed on the way out
+	var runs, temporary []string
+	defer func() {
+		for _, name := range temporary {
+			os.Remove(name)
+		}
+	}()
+
+	reader := bufio.NewReader(r)
+	var records []string
+	var size int64
+
+	for {
+		line, readErr := reader.ReadString('\n')
+		if readErr != nil && readErr != io.EOF {
+			return readErr
+		}
+
+		if line != "" {
+			records = append(records, strings.TrimSuffix(line, "\n"))
+			size += int64(len(line)) + recordOverhead
+		}
+
+		if size >= limit && len(records) > 0 {
+			run, err := spill(records, compare, opts.TempDir)
+			if run != "" {
+				temporary = append(temporary, run)
+			}
+			if err != nil {
+				return err
+			}
+			runs = append(runs, run)
+
+			// drop the spilled records but keep the slice for the next run
+			clear(records)
+			records = records[:0]
+			size = 0
+		}
+
+		if readErr == io.EOF {
+			break
+		}
+	}
+
+	sorting.MergeSortFunc(records, compare)
+
+	out := bufio.NewWriter(w)
+	if len(runs) == 0 {
+		for _, record := range records {
+			if err := writeRecord(out, record); err != nil {
+				return err
+			}
+		}
+		return out.Flush()
+	}
+
+	// merge consecutive groups of runs, so that equal records still come
+	// out oldest run first, until the rest fit in one final merge
+	for len(runs) > fanIn {
+		var merged []string
+		for start := 0; start < len(runs); start += fanIn {
+			group := runs[start:min(start+fanIn, len(runs))]
+			if len(group) == 1 {
+				merged = append(merged, group[0])
+				continue
+			}
+
+			run, err := mergeToFile(group, compare, opts.TempDir)
+			if run != "" {
+				temporary = append(temporary, run)
+			}
+			if err != nil {
+				return err
+			}
+			merged = append(merged, run)
+
+			for _, name := range group {
+				os.Remove(name)
+			}
+		}
+		runs = merged
+	}
+
+	// the last run stays in memory and takes part in the merge as the
+	// newest one
+	if err := mergeRuns(out, runs, records, compare); err != nil {
+		return err
+	}
+
+	return out.Flush()
+}
+
+// mergeToFile merges the runs into a new temporary file and returns its
+// name.
+func mergeToFile(runs []string, compare func(a, b string) int, dir string) (name string, err error) {
+	f, err := os.CreateTemp(dir, "extsort-run-*")
+	if err != nil {
+		return "", err
+	}
+	defer func() {
+		if closeErr := f.Close(); err == nil {
+			err = closeErr
+		}
+	}()
+
+	w := bufio.NewWriter(f)
+	if err := mergeRuns(w, runs, nil, compare); err != nil {
+		return f.Name(), err
+	}
+
+	return f.Name(), w.Flush()
+}
+
+func writeRecord(w *bufio.Writer, record string) error {
+	if _, err := w.WriteString(record); err != nil {
+		return err
+	}
+
+	return w.WriteByte('\n')
+}
+
+// spill sorts records, writes them to a new temporary file and returns
+// its name. The file is closed, so spilled runs hold no descriptors until
+// they are merged.
+func spill(records []string, compare func(a, b string) int, dir string) (name string, err error) {
+	sorting.MergeSortFunc(records, compare)
+
+	f, err := os.CreateTemp(dir, "extsort-run-*")
+	if err != nil {
+		return "", err
+	}
+	defer func() {
+		if closeErr := f.Close(); err == nil {
+			err = closeErr
+		}
+	}()
+
+	w := bufio.NewWriter(f)
+	for _, record := range records {
+		if err := writeRecord(w, record); err != nil {
+			return f.Name(), err
+		}
+	}
+
+	return f.Name(), w.Flush()
+}
+
+// source yields the records of one sorted run in order.
+type source struct {
+	index  int
+	reader *bufio.Reader
+	memory []string
+	head   string
+}
+
+// next moves to the following record and reports whether there was one.
+func (s *source) next() (bool, error) {
+	if s.reader == nil {
+		if len(s.memory) == 0 {
+			return false, nil
+		}
+
+		s.head, s.memory = s.memory[0], s.memory[1:]
+		return true, nil
+	}
+
+	line, err := s.reader.ReadString('\n')
+	if err == io.EOF && line == "" {
+		return false, nil
+	}
+	if err != nil && err != io.EOF {
+		return false, err
+	}
+
+	s.head = strings.TrimSuffix(line, "\n")
+	return true, nil
+}
+
+// sourceHeap is a min-heap of sources ordered by their current record.
+// Ties go to the earlier run, which keeps the merge stable.
+type sourceHeap struct {
+	sources []*source
+	compare func(a, b string) int
+}
+
+func (h *sourceHeap) Len() int { return len(h.sources) }
+
+func (h *sourceHeap) Less(i, j int) bool {
+	a, b := h.sources[i], h.sources[j]
+	if c := h.compare(a.head, b.head); c != 0 {
+		return c < 0
+	}
+
+	return a.index < b.index
+}
+
+func (h *sourceHeap) Swap(i, j int) { h.sources[i], h.sources[j] = h.sources[j], h.sources[i] }
+
+func (h *sourceHeap) Push(x any) { h.sources = append(h.sources, x.(*source)) }
+
+func (h *sourceHeap) Pop() any {
+	last := h.sources[len(h.sources)-1]
+	h.sources = h.sources[:len(h.sources)-1]
+	return last
+}
+
+// mergeRuns performs a k-way merge of the run files and then the records
+// in last: the heap always holds the current record of every run, so each
+// output record costs O(log k).
+func mergeRuns(w *bufio.Writer, runs []string, last []string, compare func(a, b string) int) error {
+	h := &sourceHeap{compare: compare}
+
+	all := make([]*source, 0, len(runs)+1)
+	for i, name := range runs {
+		f, err := os.Open(name)
+		if err != nil {
+			return err
+		}
+		defer f.Close()
+
+		all = append(all, &source{index: i, reader: bufio.NewReader(f)})
+	}
+	all = append(all, &source{index: len(runs), memory: last})
+
+	for _, s := range all {
+		ok, err := s.next()
+		if err != nil {
+			return err
+		}
+		if ok {
+			h.sources = append(h.sources, s)
+		}
+	}
+	heap.Init(h)
+
+	for h.Len() > 0 {
+		s := h.sources[0]
+		if err := writeRecord(w, s.head); err != nil {
+			return err
+		}
+
+		ok, err := s.next()
+		if err != nil {
+			return err
+		}
+		if ok {
+			heap.Fix(h, 0)
+		} else {
+			heap.Pop(h)
+		}
+	}
+
+	return nil
+}
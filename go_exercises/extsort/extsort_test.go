@@ -0,0 +1,52 @@
+package extsort
+
+import (
+	"fmt"
+	"math/rand/v2"
+	"os"
+	"slices"
+	"strings"
+	"testing"
+)
+
+func TestSortBoundedFanIn(t *testing.T) {
+	rng := rand.New(rand.NewPCG(1, 2))
+
+	// keys repeat so stability shows: each record is key:position
+	var records []string
+	for i := range 5000 {
+		records = append(records, fmt.Sprintf("%03d:%05d", rng.IntN(200), i))
+	}
+	byKey := func(a, b string) int {
+		return strings.Compare(a[:3], b[:3])
+	}
+	want := slices.Clone(records)
+	slices.SortStableFunc(want, byKey)
+
+	for _, fanIn := range []int{1, 2, 3, 64} {
+		dir := t.TempDir()
+		var out strings.Builder
+		err := Sort(strings.NewReader(strings.Join(records, "\n")), &out, Options{
+			MemoryLimit: 512,
+			TempDir:     dir,
+			Compare:     byKey,
+			MaxOpenRuns: fanIn,
+		})
+		if err != nil {
+			t.Fatalf("MaxOpenRuns %d: Sort error: %v", fanIn, err)
+		}
+
+		got := strings.Split(strings.TrimSuffix(out.String(), "\n"), "\n")
+		if !slices.Equal(got, want) {
+			t.Errorf("MaxOpenRuns %d: output is not the stable sort of the input", fanIn)
+		}
+
+		left, err := os.ReadDir(dir)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if len(left) != 0 {
+			t.Errorf("MaxOpenRuns %d: %d temporary files left behind", fanIn, len(left))
+		}
+	}
+}
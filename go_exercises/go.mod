@@ -0,0 +1,3 @@
+module github.com/devluxor/common-sense-guide-to-dsa/go_exercises
+
+go 1.24
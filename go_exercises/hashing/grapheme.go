@@ -0,0 +1,87 @@
+package hashing
+
+import (
+	"iter"
+	"unicode"
+	"unicode/utf8"
+)
+
+const (
+	zeroWidthJoiner = '\u200D'
+	regionalFirst   = '\U0001F1E6'
+	regionalLast    = '\U0001F1FF'
+	modifierFirst   = '\U0001F3FB'
+	modifierLast    = '\U0001F3FF'
+)
+
+// extends reports whether r attaches to the grapheme cluster before it.
+func extends(r rune) bool {
+	return unicode.In(r, unicode.Mn, unicode.Me, unicode.Mc, unicode.Variation_Selector) ||
+		r == zeroWidthJoiner || (r >= modifierFirst && r <= modifierLast)
+}
+
+func isRegional(r rune) bool {
+	return r >= regionalFirst && r <= regionalLast
+}
+
+// graphemes returns an iterator over the grapheme clusters of s and the
+// byte offsets where they start. It covers the cases that matter for
+// counting characters: combining marks, CR LF, flags made of two regional
+// indicators, emoji modifiers and sequences joined by U+200D. Other rules
+// of Unicode text segmentation, such as Hangul jamo, are not applied, so
+// those runes come out one cluster each.
+func graphemes(s string) iter.Seq2[int, string] {
+	return func(yield func(int, string) bool) {
+		for start := 0; start < len(s); {
+			first, size := utf8.DecodeRuneInString(s[start:])
+			end := start + size
+			previous := first
+			regionals := 0
+			if isRegional(first) {
+				regionals = 1
+			}
+
+			for end < len(s) {
+				r, size := utf8.DecodeRuneInString(s[end:])
+				joined := previous == zeroWidthJoiner ||
+					(previous == '\r' && r == '\n') ||
+					(regionals == 1 && isRegional(r))
+				if !joined && !extends(r) {
+					break
+				}
+				if isRegional(r) {
+					regionals++
+				}
+				previous = r
+				end += size
+			}
+
+			if !yield(start, s[start:end]) {
+				return
+			}
+			start = end
+		}
+	}
+}
+
+// foldRune maps r to a single representative of its case-folding orbit,
+// the smallest rune that unicode.SimpleFold cycles through, so that 'K',
+// 'k' and the Kelvin sign all fold to 'K'.
+func foldRune(r rune) rune {
+	smallest := r
+	for f := unicode.SimpleFold(r); f != r; f = unicode.SimpleFold(f) {
+		smallest = min(smallest, f)
+	}
+
+	return smallest
+}
+
+// foldString applies foldRune to every rune of s.
+func foldString(s string) string {
+	folded := make([]rune, 0, len(s))
+	for _, r := range s {
+		folded = append(folded, foldRune(r))
+	}
+
+	return string(folded)
+}
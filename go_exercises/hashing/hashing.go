@@ -0,0 +1,136 @@
+// Package hashing collects small algorithms that trade memory for speed by
+// using a hash table (a Go map) for O(1) lookups.
+//
+// Every function has a Counted variant that reports each hash table lookup
+// or insertion to a steps.Counter.
+package hashing
+
+import (
+	"github.com/devluxor/common-sense-guide-to-dsa/go_exercises/internal/constraints"
+	"github.com/devluxor/common-sense-guide-to-dsa/go_exercises/steps"
+)
+
+// Integer is satisfied by every integer type.
+type Integer = constraints.Integer
+
+// Number is satisfied by every integer and floating-point type.
+type Number interface {
+	Integer | ~float32 | ~float64
+}
+
+// TwoSum reports whether any two values in numbers add up to target. It
+// builds the hash table and checks the condition in a single pass: O(N).
+func TwoSum[T Number](numbers []T, target T) bool {
+	return TwoSumCounted(numbers, target, nil)
+}
+
+// TwoSumCounted is TwoSum reporting its hash table steps to c.
+func TwoSumCounted[T Number](numbers []T, target T, c *steps.Counter) bool {
+	seen := make(map[T]bool)
+	for _, val := range numbers {
+		c.HashLookup()
+		if seen[target-val] {
+			return true
+		}
+
+		c.HashLookup()
+		seen[val] = true
+	}
+
+	return false
+}
+
+// FirstDuplicate returns the first value that appears a second time.
+func FirstDuplicate[T comparable](values []T) (T, bool) {
+	return FirstDuplicateCounted(values, nil)
+}
+
+// FirstDuplicateCounted is FirstDuplicate reporting its hash table steps
+// to c.
+func FirstDuplicateCounted[T comparable](values []T, c *steps.Counter) (T, bool) {
+	seen := make(map[T]bool)
+	for _, v := range values {
+		c.HashLookup()
+		if seen[v] {
+			return v, true
+		}
+
+		c.HashLookup()
+		seen[v] = true
+	}
+
+	var zero T
+	return zero, false
+}
+
+// LongestSequence returns the length of the longest run of consecutive
+// integers in a, in any order. Only numbers that start a run are expanded,
+// so every number is visited a constant number of times: O(N).
+func LongestSequence[T Integer](a []T) int {
+	return LongestSequenceCounted(a, nil)
+}
+
+// LongestSequenceCounted is LongestSequence reporting its hash table steps
+// to c.
+func LongestSequenceCounted[T Integer](a []T, c *steps.Counter) int {
+	hash := make(map[T]bool)
+
+	for _, n := range a {
+		c.HashLookup()
+		hash[n] = true
+	}
+
+	longest := 0
+	for n := range hash {
+		c.HashLookup()
+		// n-1 wraps around past the smallest value of T, which starts a run
+		if n-1 < n && hash[n-1] {
+			continue
+		}
+
+		currentLength := 0
+		for next := n; ; next++ {
+			c.HashLookup()
+			if !hash[next] {
+				break
+			}
+			currentLength += 1
+
+			// the largest value of T ends every run it is in
+			if next+1 < next {
+				break
+			}
+		}
+
+		if currentLength > longest {
+			longest = currentLength
+		}
+	}
+
+	return longest
+}
+
+// Common returns the items of b whose key also appears among the items of
+// a, in the order they appear in b.
+func Common[T any, K comparable](a, b []T, key func(T) K) []T {
+	return CommonCounted(a, b, key, nil)
+}
+
+// CommonCounted is Common reporting its hash table steps to c.
+func CommonCounted[T any, K comparable](a, b []T, key func(T) K, c *steps.Counter) []T {
+	keys := make(map[K]bool)
+	for _, item := range a {
+		c.HashLookup()
+		keys[key(item)] = true
+	}
+
+	output := []T{}
+	for _, item := range b {
+		c.HashLookup()
+		if keys[key(item)] {
+			output = append(output, item)
+		}
+	}
+
+	return output
+}
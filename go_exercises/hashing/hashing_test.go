@@ -0,0 +1,43 @@
+package hashing
+
+import (
+	"math"
+	"testing"
+)
+
+func TestLongestSequence(t *testing.T) {
+	ints := []struct {
+		a    []int
+		want int
+	}{
+		{nil, 0},
+		{[]int{10, 5, 12, 3, 55, 30, 4, 11, 2}, 4},
+		{[]int{19, 13, 15, 12, 18, 14, 17, 11}, 5},
+		{[]int{1, 1, 2, 2}, 2},
+		{[]int{math.MaxInt, math.MinInt}, 1},
+		{[]int{math.MaxInt - 1, math.MaxInt}, 2},
+	}
+	for _, test := range ints {
+		if got := LongestSequence(test.a); got != test.want {
+			t.Errorf("LongestSequence(%v) = %d, want %d", test.a, got, test.want)
+		}
+	}
+
+	if got := LongestSequence([]uint8{255, 0}); got != 1 {
+		t.Errorf("LongestSequence([]uint8{255, 0}) = %d, want 1", got)
+	}
+	if got := LongestSequence([]uint8{254, 255, 0, 1}); got != 2 {
+		t.Errorf("LongestSequence([]uint8{254, 255, 0, 1}) = %d, want 2", got)
+	}
+	if got := LongestSequence([]int8{127, -128}); got != 1 {
+		t.Errorf("LongestSequence([]int8{127, -128}) = %d, want 1", got)
+	}
+
+	all := make([]int8, 0, 256)
+	for n := math.MinInt8; n <= math.MaxInt8; n++ {
+		all = append(all, int8(n))
+	}
+	if got := LongestSequence(all); got != 256 {
+		t.Errorf("LongestSequence(every int8) = %d, want 256", got)
+	}
+}
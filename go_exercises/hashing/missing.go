@@ -0,0 +1,118 @@
+package hashing
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/devluxor/common-sense-guide-to-dsa/go_exercises/steps"
+)
+
+// EnglishAlphabet is the alphabet of the missing letter exercise.
+const EnglishAlphabet = "abcdefghijklmnopqrstuvwxyz"
+
+// MaxMissingRange is the largest range, hi - lo + 1, MissingInRange
+// accepts: every integer in it is looked up, and may end up in the result.
+const MaxMissingRange = 1 << 24
+
+var (
+	// ErrEmptyAlphabet is returned by MissingFrom for an empty alphabet.
+	ErrEmptyAlphabet = errors.New("hashing: empty alphabet")
+	// ErrEmptyInput is returned by MissingInRange when there are no
+	// numbers to look at.
+	ErrEmptyInput = errors.New("hashing: empty input")
+	// ErrInvalidRange is returned by MissingInRange when lo > hi.
+	ErrInvalidRange = errors.New("hashing: invalid range")
+	// ErrRangeTooLarge is returned by MissingInRange when the range
+	// exceeds MaxMissingRange.
+	ErrRangeTooLarge = errors.New("hashing: range too large")
+)
+
+// MissingOptions choose how MissingFrom matches text against the alphabet.
+type MissingOptions struct {
+	// FoldCase treats letters that differ only in case as the same, so
+	// "Quick" contains the "q" of a lowercase alphabet.
+	FoldCase bool
+}
+
+// MissingFrom returns the symbols of alphabet that do not occur in text, in
+// alphabet order and without repeats. Both strings may hold any Unicode
+// runes; text runes outside the alphabet, such as spaces or punctuation,
+// are ignored. It hashes the text once and checks every symbol once:
+// O(N + A).
+func MissingFrom(alphabet, text string, opts MissingOptions) ([]rune, error) {
+	return MissingFromCounted(alphabet, text, opts, nil)
+}
+
+// MissingFromCounted is MissingFrom reporting its hash table steps to c.
+func MissingFromCounted(alphabet, text string, opts MissingOptions, c *steps.Counter) ([]rune, error) {
+	if alphabet == "" {
+		return nil, ErrEmptyAlphabet
+	}
+
+	key := func(r rune) rune { return r }
+	if opts.FoldCase {
+		key = foldRune
+	}
+
+	present := make(map[rune]bool)
+	for _, r := range text {
+		c.HashLookup()
+		present[key(r)] = true
+	}
+
+	missing := []rune{}
+	for _, r := range alphabet {
+		c.HashLookup()
+		if !present[key(r)] {
+			missing = append(missing, r)
+			// repeated alphabet symbols are reported once
+			present[key(r)] = true
+		}
+	}
+
+	return missing, nil
+}
+
+// MissingInRange returns every integer from lo to hi inclusive that does
+// not occur in nums, in ascending order. Numbers outside the range are
+// ignored. It runs in O(N + M) for a range of M integers, which is also
+// the largest the result can be, so ranges wider than MaxMissingRange are
+// rejected with ErrRangeTooLarge.
+func MissingInRange[T Integer](nums []T, lo, hi T) ([]T, error) {
+	return MissingInRangeCounted(nums, lo, hi, nil)
+}
+
+// MissingInRangeCounted is MissingInRange reporting its hash table steps
+// to c.
+func MissingInRangeCounted[T Integer](nums []T, lo, hi T, c *steps.Counter) ([]T, error) {
+	if len(nums) == 0 {
+		return nil, ErrEmptyInput
+	}
+	if lo > hi {
+		return nil, fmt.Errorf("%w: %v > %v", ErrInvalidRange, lo, hi)
+	}
+	// unsigned arithmetic wraps, so the distance is right for signed types
+	if uint64(hi)-uint64(lo) >= MaxMissingRange {
+		return nil, fmt.Errorf("%w: [%v, %v]", ErrRangeTooLarge, lo, hi)
+	}
+
+	present := make(map[T]bool, len(nums))
+	for _, n := range nums {
+		c.HashLookup()
+		present[n] = true
+	}
+
+	missing := []T{}
+	// stop before incrementing so hi may be the largest value of T
+	for n := lo; ; n++ {
+		c.HashLookup()
+		if !present[n] {
+			missing = append(missing, n)
+		}
+		if n == hi {
+			break
+		}
+	}
+
+	return missing, nil
+}
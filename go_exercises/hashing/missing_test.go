@@ -0,0 +1,57 @@
+package hashing
+
+import (
+	"errors"
+	"math"
+	"slices"
+	"testing"
+)
+
+func TestMissingInRange(t *testing.T) {
+	tests := []struct {
+		nums   []int
+		lo, hi int
+		want   []int
+	}{
+		{[]int{2, 3, 0, 6, 1, 5}, 0, 6, []int{4}},
+		{[]int{8, 2, 3, 9, 4, 7, 5, 0, 6}, 0, 9, []int{1}},
+		{[]int{1, 2, 3}, 1, 3, []int{}},
+		{[]int{-1, 7}, 0, 3, []int{0, 1, 2, 3}},
+		{[]int{math.MaxInt}, math.MaxInt - 2, math.MaxInt, []int{math.MaxInt - 2, math.MaxInt - 1}},
+	}
+
+	for _, test := range tests {
+		got, err := MissingInRange(test.nums, test.lo, test.hi)
+		if err != nil {
+			t.Errorf("MissingInRange(%v, %d, %d) error: %v", test.nums, test.lo, test.hi, err)
+			continue
+		}
+		if !slices.Equal(got, test.want) {
+			t.Errorf("MissingInRange(%v, %d, %d) = %v, want %v", test.nums, test.lo, test.hi, got, test.want)
+		}
+	}
+}
+
+func TestMissingInRangeErrors(t *testing.T) {
+	tests := []struct {
+		nums   []int
+		lo, hi int
+		want   error
+	}{
+		{nil, 0, 1, ErrEmptyInput},
+		{[]int{1}, 2, 1, ErrInvalidRange},
+		{[]int{1}, math.MinInt, math.MaxInt, ErrRangeTooLarge},
+		{[]int{1}, 0, MaxMissingRange, ErrRangeTooLarge},
+	}
+
+	for _, test := range tests {
+		_, err := MissingInRange(test.nums, test.lo, test.hi)
+		if !errors.Is(err, test.want) {
+			t.Errorf("MissingInRange(%v, %d, %d) error = %v, want %v", test.nums, test.lo, test.hi, err, test.want)
+		}
+	}
+
+	if _, err := MissingInRange([]uint8{7}, 0, math.MaxUint8); err != nil {
+		t.Errorf("MissingInRange over all of uint8 error: %v", err)
+	}
+}
@@ -0,0 +1,159 @@
+package hashing
+
+import (
+	"iter"
+	"unicode/utf8"
+
+	"github.com/devluxor/common-sense-guide-to-dsa/go_exercises/counter"
+	"github.com/devluxor/common-sense-guide-to-dsa/go_exercises/list"
+	"github.com/devluxor/common-sense-guide-to-dsa/go_exercises/steps"
+)
+
+// UniqueOptions choose what FirstUnique and AllUnique count as a
+// character.
+type UniqueOptions struct {
+	// Graphemes makes a character a grapheme cluster, such as "e" followed
+	// by a combining accent or a flag emoji, instead of a single rune.
+	Graphemes bool
+	// FoldCase treats characters that differ only in case as the same.
+	FoldCase bool
+}
+
+// Unique is a character that appears exactly once in a string.
+type Unique struct {
+	// Text is the character as it appears in the string.
+	Text string
+	// Index is the byte offset of Text in the string.
+	Index int
+}
+
+// FirstUnique returns the first character of s that is not repeated
+// anywhere in it. It counts every character in one pass and finds the first
+// with a count of one in a second pass over s, so the answer does not
+// depend on map iteration order: O(N).
+func FirstUnique(s string, opts UniqueOptions) (Unique, bool) {
+	return FirstUniqueCounted(s, opts, nil)
+}
+
+// FirstUniqueCounted is FirstUnique reporting its hash table steps to c.
+func FirstUniqueCounted(s string, opts UniqueOptions, c *steps.Counter) (Unique, bool) {
+	for u := range uniques(s, opts, c) {
+		return u, true
+	}
+
+	return Unique{}, false
+}
+
+// AllUnique returns every character of s that is not repeated anywhere in
+// it, in the order they appear.
+func AllUnique(s string, opts UniqueOptions) []Unique {
+	return AllUniqueCounted(s, opts, nil)
+}
+
+// AllUniqueCounted is AllUnique reporting its hash table steps to c.
+func AllUniqueCounted(s string, opts UniqueOptions, c *steps.Counter) []Unique {
+	output := []Unique{}
+	for u := range uniques(s, opts, c) {
+		output = append(output, u)
+	}
+
+	return output
+}
+
+func uniques(s string, opts UniqueOptions, c *steps.Counter) iter.Seq[Unique] {
+	return func(yield func(Unique) bool) {
+		counts := counter.New[string]()
+		for _, char := range characters(s, opts) {
+			c.HashLookup()
+			counts.Add(characterKey(char, opts))
+		}
+
+		for i, char := range characters(s, opts) {
+			c.HashLookup()
+			if counts.Count(characterKey(char, opts)) == 1 {
+				if !yield(Unique{Text: char, Index: i}) {
+					return
+				}
+			}
+		}
+	}
+}
+
+func characters(s string, opts UniqueOptions) iter.Seq2[int, string] {
+	if opts.Graphemes {
+		return graphemes(s)
+	}
+
+	return func(yield func(int, string) bool) {
+		// invalid bytes decode as one-byte RuneErrors, so take the width
+		// from the decoder rather than from the rune
+		for i := 0; i < len(s); {
+			_, size := utf8.DecodeRuneInString(s[i:])
+			if !yield(i, s[i:i+size]) {
+				return
+			}
+			i += size
+		}
+	}
+}
+
+func characterKey(char string, opts UniqueOptions) string {
+	if opts.FoldCase {
+		return foldString(char)
+	}
+
+	return char
+}
+
+// UniqueStream tracks the first non-repeated rune of a text that arrives
+// one rune at a time. Runes seen once wait in a doubly linked list in
+// arrival order; a rune seen again is unlinked in O(1) through the map, so
+// both Add and First take O(1). The zero value is ready to use.
+type UniqueStream struct {
+	// FoldCase treats runes that differ only in case as the same. Set it
+	// before the first Add.
+	FoldCase bool
+
+	candidates list.DoublyList[Unique]
+	nodes      map[rune]*list.DoublyNode[Unique]
+	position   int
+}
+
+// Add appends r to the text. Indexes count runes from zero, since a
+// stream has no string to take byte offsets from.
+func (s *UniqueStream) Add(r rune) {
+	if s.nodes == nil {
+		s.nodes = make(map[rune]*list.DoublyNode[Unique])
+	}
+
+	key := r
+	if s.FoldCase {
+		key = foldRune(r)
+	}
+
+	node, seen := s.nodes[key]
+	switch {
+	case !seen:
+		s.nodes[key] = s.candidates.PushBack(Unique{Text: string(r), Index: s.position})
+	case node != nil:
+		// seen for the second time: no longer a candidate, ever
+		s.candidates.Remove(node)
+		s.nodes[key] = nil
+	}
+
+	s.position++
+}
+
+// First returns the first rune added so far that has not been repeated.
+func (s *UniqueStream) First() (Unique, bool) {
+	if head := s.candidates.Head(); head != nil {
+		return head.Data, true
+	}
+
+	return Unique{}, false
+}
+
+// Len returns the number of runes added so far.
+func (s *UniqueStream) Len() int {
+	return s.position
+}
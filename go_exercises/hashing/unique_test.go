@@ -0,0 +1,53 @@
+package hashing
+
+import (
+	"slices"
+	"testing"
+)
+
+func TestFirstUnique(t *testing.T) {
+	tests := []struct {
+		s    string
+		opts UniqueOptions
+		want Unique
+		ok   bool
+	}{
+		{"minimum", UniqueOptions{}, Unique{"n", 2}, true},
+		{"iiiiiua", UniqueOptions{}, Unique{"u", 5}, true},
+		{"aiiiiiiiiu", UniqueOptions{}, Unique{"a", 0}, true},
+		{"aabb", UniqueOptions{}, Unique{}, false},
+		{"", UniqueOptions{}, Unique{}, false},
+		{"Aab", UniqueOptions{FoldCase: true}, Unique{"b", 2}, true},
+		{"\u212Akx", UniqueOptions{FoldCase: true}, Unique{"x", 4}, true},
+		{"ée", UniqueOptions{Graphemes: true}, Unique{"é", 0}, true},
+		{"ée", UniqueOptions{}, Unique{"́", 1}, true},
+		{"ab\xffc", UniqueOptions{}, Unique{"a", 0}, true},
+		{"aa\xffb", UniqueOptions{}, Unique{"\xff", 2}, true},
+	}
+
+	for _, test := range tests {
+		got, ok := FirstUnique(test.s, test.opts)
+		if got != test.want || ok != test.ok {
+			t.Errorf("FirstUnique(%q, %+v) = %v, %v, want %v, %v", test.s, test.opts, got, ok, test.want, test.ok)
+		}
+	}
+}
+
+func TestAllUniqueInvalidUTF8(t *testing.T) {
+	want := []Unique{{"a", 0}, {"b", 1}, {"\xff", 2}, {"c", 3}}
+	if got := AllUnique("ab\xffc", UniqueOptions{}); !slices.Equal(got, want) {
+		t.Errorf("AllUnique = %q, want %q", got, want)
+	}
+}
+
+func TestUniqueStream(t *testing.T) {
+	var stream UniqueStream
+	want := "mmmmnnn"
+	for i, r := range "minimum" {
+		stream.Add(r)
+		first, ok := stream.First()
+		if !ok || first.Text != string(want[i]) {
+			t.Fatalf("after %q, First() = %v, %v, want %q", "minimum"[:i+1], first, ok, want[i])
+		}
+	}
+}
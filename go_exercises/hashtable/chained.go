@@ -0,0 +1,115 @@
+package hashtable
+
+import "iter"
+
+// Chained resolves collisions by separate chaining: every bucket holds a
+// slice of the entries that hash to it, and lookups scan it. The load
+// factor may exceed 1, at the cost of longer chains.
+type Chained[K comparable, V any] struct {
+	table[K, V]
+	buckets [][]entry[K, V]
+}
+
+// NewChained returns an empty table that chains colliding entries.
+func NewChained[K comparable, V any](opts Options[K]) *Chained[K, V] {
+	t, buckets := newTable[K, V](opts)
+	return &Chained[K, V]{table: t, buckets: make([][]entry[K, V], buckets)}
+}
+
+func (t *Chained[K, V]) bucket(hash uint64) int {
+	return int(hash & uint64(len(t.buckets)-1))
+}
+
+// Get returns the value stored under key.
+func (t *Chained[K, V]) Get(key K) (V, bool) {
+	hash := t.hash(key)
+	for _, e := range t.buckets[t.bucket(hash)] {
+		if e.hash == hash && e.key == key {
+			return e.value, true
+		}
+	}
+
+	var zero V
+	return zero, false
+}
+
+// Put stores value under key, replacing any previous value.
+func (t *Chained[K, V]) Put(key K, value V) {
+	hash := t.hash(key)
+	chain := t.buckets[t.bucket(hash)]
+	for i := range chain {
+		if chain[i].hash == hash && chain[i].key == key {
+			chain[i].value = value
+			return
+		}
+	}
+
+	if t.full(len(t.buckets)) {
+		t.resize(len(t.buckets) * 2)
+	}
+
+	b := t.bucket(hash)
+	if len(t.buckets[b]) > 0 {
+		t.collisions++
+	}
+	t.buckets[b] = append(t.buckets[b], entry[K, V]{key: key, value: value, hash: hash})
+	t.length++
+}
+
+// Delete removes key and reports whether it was present.
+func (t *Chained[K, V]) Delete(key K) bool {
+	hash := t.hash(key)
+	b := t.bucket(hash)
+	chain := t.buckets[b]
+	for i := range chain {
+		if chain[i].hash == hash && chain[i].key == key {
+			// move the last entry into the gap; chains are unordered
+			last := len(chain) - 1
+			chain[i] = chain[last]
+			chain[last] = entry[K, V]{}
+			t.buckets[b] = chain[:last]
+			t.length--
+			return true
+		}
+	}
+
+	return false
+}
+
+func (t *Chained[K, V]) resize(buckets int) {
+	old := t.buckets
+	t.buckets = make([][]entry[K, V], buckets)
+	for _, chain := range old {
+		for _, e := range chain {
+			b := t.bucket(e.hash)
+			t.buckets[b] = append(t.buckets[b], e)
+		}
+	}
+	t.resizes++
+}
+
+// All returns an iterator over the entries in bucket order.
+func (t *Chained[K, V]) All() iter.Seq2[K, V] {
+	return func(yield func(K, V) bool) {
+		for _, chain := range t.buckets {
+			for _, e := range chain {
+				if !yield(e.key, e.value) {
+					return
+				}
+			}
+		}
+	}
+}
+
+// Metrics reports the table's current shape. The probe length of an entry
+// is its position in its chain. It takes O(buckets + N).
+func (t *Chained[K, V]) Metrics() Metrics {
+	histogram := []int{}
+	for _, chain := range t.buckets {
+		for d := range chain {
+			histogram = record(histogram, d)
+		}
+	}
+
+	return t.metrics(len(t.buckets), histogram)
+}
@@ -0,0 +1,71 @@
+package hashtable
+
+import (
+	"hash/maphash"
+
+	"github.com/devluxor/common-sense-guide-to-dsa/go_exercises/internal/constraints"
+)
+
+// HashFunc maps a key to a 64-bit hash. Tables use the low bits to pick a
+// bucket, so a good HashFunc spreads keys across them.
+type HashFunc[K comparable] func(key K) uint64
+
+// Integer is satisfied by every integer type.
+type Integer = constraints.Integer
+
+// DefaultHash returns a randomly seeded hash for any key type, built on
+// maphash.Comparable, so keys that are equal under == always hash alike:
+// pointers by address, 0.0 and -0.0 to the same value.
+func DefaultHash[K comparable]() HashFunc[K] {
+	seed := maphash.MakeSeed()
+	return func(key K) uint64 {
+		return maphash.Comparable(seed, key)
+	}
+}
+
+// StringHash returns a randomly seeded hash for strings, as used by Go's
+// own maps.
+func StringHash() HashFunc[string] {
+	seed := maphash.MakeSeed()
+	return func(key string) uint64 {
+		return maphash.String(seed, key)
+	}
+}
+
+// FNV1a hashes key with 64-bit FNV-1a. It is deterministic, which makes
+// runs reproducible, but easy to attack with chosen keys.
+func FNV1a(key string) uint64 {
+	const (
+		offset = 14695981039346656037
+		prime  = 1099511628211
+	)
+
+	h := uint64(offset)
+	for i := 0; i < len(key); i++ {
+		h ^= uint64(key[i])
+		h *= prime
+	}
+
+	return h
+}
+
+// IntegerHash returns a hash for integers that mixes every input bit into
+// the low bits with the SplitMix64 finalizer. It is deterministic.
+func IntegerHash[K Integer]() HashFunc[K] {
+	return func(key K) uint64 {
+		h := uint64(key)
+		h ^= h >> 30
+		h *= 0xbf58476d1ce4e5b9
+		h ^= h >> 27
+		h *= 0x94d049bb133111eb
+		h ^= h >> 31
+		return h
+	}
+}
+
+// Identity hashes an integer to itself. With the low-bit bucket selection
+// of the tables, it is perfect for dense ranges of keys and terrible for
+// keys that share their low bits, such as multiples of a power of two.
+func Identity[K Integer](key K) uint64 {
+	return uint64(key)
+}
@@ -0,0 +1,148 @@
+// Package hashtable implements the hash tables of chapter 7 by hand, with
+// three ways of resolving collisions, so that they can be measured against
+// each other and against Go's builtin map.
+//
+// Every table finds a key's home bucket from the low bits of its hash, grows
+// to twice its size when inserting would push the load factor over the
+// configured maximum, and never shrinks. Deleting from the open-addressing
+// tables shifts the following entries back instead of leaving tombstones.
+// The tables are not safe for concurrent use.
+package hashtable
+
+import (
+	"fmt"
+	"iter"
+)
+
+// HashTable is the map API shared by Chained, LinearProbing and RobinHood.
+type HashTable[K comparable, V any] interface {
+	Len() int
+	Get(key K) (V, bool)
+	Put(key K, value V)
+	Delete(key K) bool
+	All() iter.Seq2[K, V]
+	Metrics() Metrics
+}
+
+var (
+	_ HashTable[int, int] = (*Chained[int, int])(nil)
+	_ HashTable[int, int] = (*LinearProbing[int, int])(nil)
+	_ HashTable[int, int] = (*RobinHood[int, int])(nil)
+)
+
+// DefaultMaxLoadFactor is the load factor a table grows at when
+// Options.MaxLoadFactor is zero.
+const DefaultMaxLoadFactor = 0.75
+
+const minBuckets = 8
+
+// Options configures a table. The zero value uses DefaultHash and
+// DefaultMaxLoadFactor.
+type Options[K comparable] struct {
+	// Hash maps keys to hashes. Equal keys must have equal hashes.
+	Hash HashFunc[K]
+	// MaxLoadFactor is the largest ratio of entries to buckets the table
+	// accepts before growing. Open addressing needs it below 1.
+	MaxLoadFactor float64
+	// Capacity is the number of entries the table should hold before its
+	// first resize.
+	Capacity int
+}
+
+// Metrics describe how full a table is and how well its hash spreads keys.
+type Metrics struct {
+	Len        int
+	Buckets    int
+	LoadFactor float64
+	// Collisions counts the insertions of new keys whose home bucket was
+	// already taken, since the table was created.
+	Collisions int
+	Resizes    int
+	// ProbeLengths is a histogram: ProbeLengths[d] keys are found d steps
+	// after their home bucket, either further down its chain or further
+	// along the array.
+	ProbeLengths       []int
+	MaxProbeLength     int
+	AverageProbeLength float64
+}
+
+func (m Metrics) String() string {
+	return fmt.Sprintf("len=%d buckets=%d load=%.2f collisions=%d resizes=%d probe avg=%.2f max=%d",
+		m.Len, m.Buckets, m.LoadFactor, m.Collisions, m.Resizes, m.AverageProbeLength, m.MaxProbeLength)
+}
+
+type entry[K comparable, V any] struct {
+	key   K
+	value V
+	hash  uint64
+}
+
+// table holds what every strategy keeps besides its buckets.
+type table[K comparable, V any] struct {
+	hash          HashFunc[K]
+	maxLoadFactor float64
+	length        int
+	collisions    int
+	resizes       int
+}
+
+func newTable[K comparable, V any](opts Options[K]) (table[K, V], int) {
+	t := table[K, V]{hash: opts.Hash, maxLoadFactor: opts.MaxLoadFactor}
+	if t.hash == nil {
+		t.hash = DefaultHash[K]()
+	}
+	if t.maxLoadFactor <= 0 {
+		t.maxLoadFactor = DefaultMaxLoadFactor
+	}
+
+	buckets := minBuckets
+	for float64(opts.Capacity) > float64(buckets)*t.maxLoadFactor {
+		buckets *= 2
+	}
+
+	return t, buckets
+}
+
+// Len returns the number of entries.
+func (t *table[K, V]) Len() int {
+	return t.length
+}
+
+// full reports whether one more entry would exceed the load factor.
+func (t *table[K, V]) full(buckets int) bool {
+	return float64(t.length+1) > float64(buckets)*t.maxLoadFactor
+}
+
+// metrics fills in Metrics from a histogram of probe lengths.
+func (t *table[K, V]) metrics(buckets int, histogram []int) Metrics {
+	m := Metrics{
+		Len:          t.length,
+		Buckets:      buckets,
+		LoadFactor:   float64(t.length) / float64(buckets),
+		Collisions:   t.collisions,
+		Resizes:      t.resizes,
+		ProbeLengths: histogram,
+	}
+
+	total := 0
+	for d, count := range histogram {
+		total += d * count
+		if count > 0 {
+			m.MaxProbeLength = d
+		}
+	}
+	if t.length > 0 {
+		m.AverageProbeLength = float64(total) / float64(t.length)
+	}
+
+	return m
+}
+
+// record adds one key at probe length d to histogram.
+func record(histogram []int, d int) []int {
+	for len(histogram) <= d {
+		histogram = append(histogram, 0)
+	}
+	histogram[d]++
+	return histogram
+}
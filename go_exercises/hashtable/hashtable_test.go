@@ -0,0 +1,89 @@
+package hashtable
+
+import (
+	"math"
+	"math/rand/v2"
+	"testing"
+)
+
+func tables[K comparable, V any](opts Options[K]) map[string]HashTable[K, V] {
+	return map[string]HashTable[K, V]{
+		"chained":        NewChained[K, V](opts),
+		"linear probing": NewLinearProbing[K, V](opts),
+		"robin hood":     NewRobinHood[K, V](opts),
+	}
+}
+
+func TestMatchesMap(t *testing.T) {
+	hashes := map[string]HashFunc[int]{
+		"default":  nil,
+		"identity": Identity[int],
+		"clumped":  func(k int) uint64 { return uint64(k % 7) },
+	}
+
+	for hashName, hash := range hashes {
+		for name, table := range tables[int, int](Options[int]{Hash: hash, MaxLoadFactor: 0.9}) {
+			t.Run(hashName+"/"+name, func(t *testing.T) {
+				r := rand.New(rand.NewPCG(1, 2))
+				want := map[int]int{}
+				for i := range 5000 {
+					k := r.IntN(300)
+					if r.IntN(3) == 0 {
+						_, ok := want[k]
+						if got := table.Delete(k); got != ok {
+							t.Fatalf("Delete(%d) = %v, want %v", k, got, ok)
+						}
+						delete(want, k)
+					} else {
+						table.Put(k, i)
+						want[k] = i
+					}
+
+					if table.Len() != len(want) {
+						t.Fatalf("Len() = %d, want %d", table.Len(), len(want))
+					}
+				}
+
+				for k := range 300 {
+					v, ok := table.Get(k)
+					if wv, wok := want[k]; v != wv || ok != wok {
+						t.Fatalf("Get(%d) = %d, %v, want %d, %v", k, v, ok, wv, wok)
+					}
+				}
+
+				seen := 0
+				for k, v := range table.All() {
+					if want[k] != v {
+						t.Fatalf("All yielded %d: %d, want %d", k, v, want[k])
+					}
+					seen++
+				}
+				if seen != len(want) {
+					t.Fatalf("All yielded %d entries, want %d", seen, len(want))
+				}
+			})
+		}
+	}
+}
+
+func TestDefaultHashFollowsEquality(t *testing.T) {
+	type point struct{ x, y int }
+	key := &point{1, 2}
+	for name, table := range tables[*point, int](Options[*point]{}) {
+		table.Put(key, 1)
+		// the key is the pointer, not what it points to
+		key.x = 10
+		if _, ok := table.Get(key); !ok {
+			t.Errorf("%s: pointer key lost after its pointee changed", name)
+		}
+		key.x = 1
+	}
+
+	negativeZero := math.Copysign(0, -1)
+	for name, table := range tables[float64, int](Options[float64]{}) {
+		table.Put(0.0, 1)
+		if _, ok := table.Get(negativeZero); !ok {
+			t.Errorf("%s: Get(-0.0) missed the entry stored under 0.0", name)
+		}
+	}
+}
@@ -0,0 +1,239 @@
+package hashtable
+
+import "iter"
+
+// slot is a bucket of an open-addressing table.
+type slot[K comparable, V any] struct {
+	entry[K, V]
+	used bool
+}
+
+// open holds what both open-addressing tables share: the slots, lookups,
+// iteration, metrics and deletion by backward shift.
+type open[K comparable, V any] struct {
+	table[K, V]
+	slots []slot[K, V]
+}
+
+func newOpen[K comparable, V any](opts Options[K]) open[K, V] {
+	t, buckets := newTable[K, V](opts)
+	// open addressing needs at least one free slot to end every probe
+	t.maxLoadFactor = min(t.maxLoadFactor, 0.95)
+	return open[K, V]{table: t, slots: make([]slot[K, V], buckets)}
+}
+
+func (t *open[K, V]) mask() uint64 {
+	return uint64(len(t.slots) - 1)
+}
+
+// distance returns how many slots after its home bucket i is, for an entry
+// with the given hash.
+func (t *open[K, V]) distance(hash uint64, i int) int {
+	return int((uint64(i) - hash) & t.mask())
+}
+
+// find returns the slot holding key, or -1.
+func (t *open[K, V]) find(key K, hash uint64, robinHood bool) int {
+	i := int(hash & t.mask())
+	for d := 0; t.slots[i].used; d++ {
+		s := &t.slots[i]
+		if s.hash == hash && s.key == key {
+			return i
+		}
+		// no entry of a Robin Hood table sits closer to home than ours
+		// would, so a richer one means the key is absent
+		if robinHood && t.distance(s.hash, i) < d {
+			return -1
+		}
+		i = int(uint64(i+1) & t.mask())
+	}
+
+	return -1
+}
+
+// remove empties slot i and shifts later entries of the same run back
+// into the gap, so that no probe sequence crosses an empty slot it used to
+// rely on. An entry may fill the gap when the gap is not before its home
+// bucket. In a Robin Hood table those entries are exactly the ones that
+// directly follow the gap and are not at home, so the shift stops at the
+// first one that is.
+func (t *open[K, V]) remove(i int, robinHood bool) {
+	gap := i
+	for next := int(uint64(i+1) & t.mask()); t.slots[next].used; next = int(uint64(next+1) & t.mask()) {
+		d := t.distance(t.slots[next].hash, next)
+		if d >= int(uint64(next-gap)&t.mask()) {
+			t.slots[gap] = t.slots[next]
+			gap = next
+		} else if robinHood {
+			break
+		}
+	}
+
+	t.slots[gap] = slot[K, V]{}
+	t.length--
+}
+
+// Get returns the value stored under key.
+func (t *open[K, V]) get(key K, robinHood bool) (V, bool) {
+	if i := t.find(key, t.hash(key), robinHood); i >= 0 {
+		return t.slots[i].value, true
+	}
+
+	var zero V
+	return zero, false
+}
+
+// All returns an iterator over the entries in slot order.
+func (t *open[K, V]) All() iter.Seq2[K, V] {
+	return func(yield func(K, V) bool) {
+		for i := range t.slots {
+			if t.slots[i].used && !yield(t.slots[i].key, t.slots[i].value) {
+				return
+			}
+		}
+	}
+}
+
+// Metrics reports the table's current shape. The probe length of an entry
+// is how many slots after its home bucket it sits. It takes O(buckets).
+func (t *open[K, V]) Metrics() Metrics {
+	histogram := []int{}
+	for i := range t.slots {
+		if t.slots[i].used {
+			histogram = record(histogram, t.distance(t.slots[i].hash, i))
+		}
+	}
+
+	return t.metrics(len(t.slots), histogram)
+}
+
+// rehash moves every entry into a table of the given size with insert.
+func (t *open[K, V]) rehash(buckets int, insert func(e entry[K, V])) {
+	old := t.slots
+	t.slots = make([]slot[K, V], buckets)
+	t.length = 0
+	for i := range old {
+		if old[i].used {
+			insert(old[i].entry)
+		}
+	}
+	t.resizes++
+}
+
+// LinearProbing resolves collisions by storing an entry in the first free
+// slot at or after its home bucket. Runs of occupied slots tend to merge
+// into long clusters as the table fills up.
+type LinearProbing[K comparable, V any] struct {
+	open[K, V]
+}
+
+// NewLinearProbing returns an empty table that probes linearly. The load
+// factor is capped at 0.95.
+func NewLinearProbing[K comparable, V any](opts Options[K]) *LinearProbing[K, V] {
+	return &LinearProbing[K, V]{open: newOpen[K, V](opts)}
+}
+
+// Get returns the value stored under key.
+func (t *LinearProbing[K, V]) Get(key K) (V, bool) {
+	return t.get(key, false)
+}
+
+// Put stores value under key, replacing any previous value.
+func (t *LinearProbing[K, V]) Put(key K, value V) {
+	hash := t.hash(key)
+	if i := t.find(key, hash, false); i >= 0 {
+		t.slots[i].value = value
+		return
+	}
+
+	if t.full(len(t.slots)) {
+		t.rehash(len(t.slots)*2, t.insert)
+	}
+	if t.slots[hash&t.mask()].used {
+		t.collisions++
+	}
+	t.insert(entry[K, V]{key: key, value: value, hash: hash})
+}
+
+func (t *LinearProbing[K, V]) insert(e entry[K, V]) {
+	i := int(e.hash & t.mask())
+	for t.slots[i].used {
+		i = int(uint64(i+1) & t.mask())
+	}
+
+	t.slots[i] = slot[K, V]{entry: e, used: true}
+	t.length++
+}
+
+// Delete removes key and reports whether it was present.
+func (t *LinearProbing[K, V]) Delete(key K) bool {
+	i := t.find(key, t.hash(key), false)
+	if i < 0 {
+		return false
+	}
+
+	t.remove(i, false)
+	return true
+}
+
+// RobinHood is linear probing that takes from the rich and gives to the
+// poor: an entry being inserted evicts any entry it passes that sits closer
+// to its own home, and carries on inserting the evicted one. Probe lengths
+// stay short and even, and a lookup can stop as soon as it passes an entry
+// closer to home than the key would be.
+type RobinHood[K comparable, V any] struct {
+	open[K, V]
+}
+
+// NewRobinHood returns an empty Robin Hood table. The load factor is capped
+// at 0.95.
+func NewRobinHood[K comparable, V any](opts Options[K]) *RobinHood[K, V] {
+	return &RobinHood[K, V]{open: newOpen[K, V](opts)}
+}
+
+// Get returns the value stored under key.
+func (t *RobinHood[K, V]) Get(key K) (V, bool) {
+	return t.get(key, true)
+}
+
+// Put stores value under key, replacing any previous value.
+func (t *RobinHood[K, V]) Put(key K, value V) {
+	hash := t.hash(key)
+	if i := t.find(key, hash, true); i >= 0 {
+		t.slots[i].value = value
+		return
+	}
+
+	if t.full(len(t.slots)) {
+		t.rehash(len(t.slots)*2, t.insert)
+	}
+	if t.slots[hash&t.mask()].used {
+		t.collisions++
+	}
+	t.insert(entry[K, V]{key: key, value: value, hash: hash})
+}
+
+func (t *RobinHood[K, V]) insert(e entry[K, V]) {
+	i := int(e.hash & t.mask())
+	for d := 0; t.slots[i].used; d++ {
+		if resident := t.distance(t.slots[i].hash, i); resident < d {
+			e, t.slots[i].entry = t.slots[i].entry, e
+			d = resident
+		}
+		i = int(uint64(i+1) & t.mask())
+	}
+
+	t.slots[i] = slot[K, V]{entry: e, used: true}
+	t.length++
+}
+
+// Delete removes key and reports whether it was present.
+func (t *RobinHood[K, V]) Delete(key K) bool {
+	i := t.find(key, t.hash(key), true)
+	if i < 0 {
+		return false
+	}
+
+	t.remove(i, true)
+	return true
+}
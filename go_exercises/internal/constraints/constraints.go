@@ -0,0 +1,9 @@
+// Package constraints holds the type constraints shared by the generic
+// packages of this module.
+package constraints
+
+// Integer is satisfied by every integer type.
+type Integer interface {
+	~int | ~int8 | ~int16 | ~int32 | ~int64 |
+		~uint | ~uint8 | ~uint16 | ~uint32 | ~uint64 | ~uintptr
+}
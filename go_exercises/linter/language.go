@@ -0,0 +1,130 @@
+package linter
+
+import (
+	"path/filepath"
+	"strings"
+)
+
+// Language tells the linter which parts of a file are not code, so braces
+// inside comments and string literals are ignored.
+type Language struct {
+	Name       string
+	Extensions []string
+
+	// Brackets lists opening and closing runes in pairs. Empty means
+	// "()[]{}".
+	Brackets string
+
+	LineComments  []string
+	BlockComments []BlockComment
+	Strings       []StringLiteral
+}
+
+// BlockComment is a comment delimited by Start and End, such as /* */.
+type BlockComment struct {
+	Start, End string
+}
+
+// StringLiteral describes a kind of string or character literal.
+type StringLiteral struct {
+	// Delimiter opens and closes the literal.
+	Delimiter string
+	// Raw literals treat backslashes as ordinary characters.
+	Raw bool
+	// Multiline literals may span lines; others end, unterminated, at the
+	// end of the line.
+	Multiline bool
+}
+
+var (
+	// Plain checks every bracket in the text.
+	Plain = Language{Name: "plain"}
+
+	Go = Language{
+		Name:          "go",
+		Extensions:    []string{".go"},
+		LineComments:  []string{"//"},
+		BlockComments: []BlockComment{{"/*", "*/"}},
+		Strings: []StringLiteral{
+			{Delimiter: `"`},
+			{Delimiter: `'`},
+			{Delimiter: "`", Raw: true, Multiline: true},
+		},
+	}
+
+	C = Language{
+		Name:          "c",
+		Extensions:    []string{".c", ".h", ".cc", ".cpp", ".hpp", ".java", ".cs"},
+		LineComments:  []string{"//"},
+		BlockComments: []BlockComment{{"/*", "*/"}},
+		Strings: []StringLiteral{
+			{Delimiter: `"`},
+			{Delimiter: `'`},
+		},
+	}
+
+	JavaScript = Language{
+		Name:          "javascript",
+		Extensions:    []string{".js", ".mjs", ".cjs", ".jsx", ".ts", ".tsx"},
+		LineComments:  []string{"//"},
+		BlockComments: []BlockComment{{"/*", "*/"}},
+		Strings: []StringLiteral{
+			{Delimiter: `"`},
+			{Delimiter: `'`},
+			{Delimiter: "`", Multiline: true},
+		},
+	}
+
+	// Python lists triple quotes first so they win over single quotes.
+	Python = Language{
+		Name:         "python",
+		Extensions:   []string{".py"},
+		LineComments: []string{"#"},
+		Strings: []StringLiteral{
+			{Delimiter: `"""`, Multiline: true},
+			{Delimiter: `'''`, Multiline: true},
+			{Delimiter: `"`},
+			{Delimiter: `'`},
+		},
+	}
+
+	Ruby = Language{
+		Name:          "ruby",
+		Extensions:    []string{".rb"},
+		LineComments:  []string{"#"},
+		BlockComments: []BlockComment{{"=begin", "=end"}},
+		Strings: []StringLiteral{
+			{Delimiter: `"`, Multiline: true},
+			{Delimiter: `'`, Multiline: true},
+		},
+	}
+)
+
+// Languages lists the built-in languages.
+var Languages = []Language{Plain, Go, C, JavaScript, Python, Ruby}
+
+// LanguageNamed returns the built-in language with the given name.
+func LanguageNamed(name string) (Language, bool) {
+	for _, l := range Languages {
+		if l.Name == name {
+			return l, true
+		}
+	}
+
+	return Language{}, false
+}
+
+// LanguageFor picks a built-in language from the extension of filename,
+// falling back to Plain.
+func LanguageFor(filename string) Language {
+	ext := strings.ToLower(filepath.Ext(filename))
+	for _, l := range Languages {
+		for _, e := range l.Extensions {
+			if e == ext {
+				return l
+			}
+		}
+	}
+
+	return Plain
+}
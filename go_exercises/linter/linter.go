@@ -0,0 +1,252 @@
+// Package linter checks that brackets are balanced, the way chapter 8 of the
+// book does with a stack: every opening bracket is pushed, and every closing
+// bracket must match the one on top of the stack. Comments and string
+// literals are skipped according to the Language.
+package linter
+
+import (
+	"fmt"
+	"io"
+	"slices"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/devluxor/common-sense-guide-to-dsa/go_exercises/stack"
+)
+
+// Kind classifies an Issue. The first three are the syntax errors described
+// in the book.
+type Kind int
+
+const (
+	// Unclosed is an opening bracket never closed (Syntax Error Type #1).
+	Unclosed Kind = iota + 1
+	// Unopened is a closing bracket with nothing to close (Syntax Error
+	// Type #2).
+	Unopened
+	// Mismatched is a closing bracket that does not match the innermost
+	// open bracket (Syntax Error Type #3).
+	Mismatched
+	// UnterminatedString is a string literal that never ends.
+	UnterminatedString
+	// UnterminatedComment is a block comment that never ends.
+	UnterminatedComment
+)
+
+// Position is a 1-based line and column; columns count runes.
+type Position struct {
+	Line, Column int
+}
+
+func (p Position) String() string {
+	return fmt.Sprintf("%d:%d", p.Line, p.Column)
+}
+
+// Issue is a single problem found by the linter.
+type Issue struct {
+	Kind     Kind
+	Position Position
+	// Found is the offending bracket, or the opening delimiter of an
+	// unterminated string or comment.
+	Found string
+	// Opening and OpeningPosition describe the open bracket a Mismatched
+	// closing bracket was checked against.
+	Opening         string
+	OpeningPosition Position
+}
+
+func (i Issue) String() string {
+	switch i.Kind {
+	case Unclosed:
+		return fmt.Sprintf("%v: unclosed %q", i.Position, i.Found)
+	case Unopened:
+		return fmt.Sprintf("%v: %q closes nothing", i.Position, i.Found)
+	case Mismatched:
+		return fmt.Sprintf("%v: %q does not match %q opened at %v", i.Position, i.Found, i.Opening, i.OpeningPosition)
+	case UnterminatedString:
+		return fmt.Sprintf("%v: unterminated string starting with %q", i.Position, i.Found)
+	case UnterminatedComment:
+		return fmt.Sprintf("%v: unterminated comment starting with %q", i.Position, i.Found)
+	}
+
+	return fmt.Sprintf("%v: unknown issue", i.Position)
+}
+
+// Lint reads all of r and checks it. The only error returned is the one
+// from reading.
+func Lint(r io.Reader, lang Language) ([]Issue, error) {
+	text, err := io.ReadAll(r)
+	if err != nil {
+		return nil, err
+	}
+
+	return LintString(string(text), lang), nil
+}
+
+type openBracket struct {
+	bracket  rune
+	position Position
+}
+
+type scanner struct {
+	text     string
+	i        int
+	position Position
+	issues   []Issue
+}
+
+// advance moves past the next n bytes, keeping track of lines and columns.
+func (s *scanner) advance(n int) {
+	for _, r := range s.text[s.i : s.i+n] {
+		if r == '\n' {
+			s.position.Line++
+			s.position.Column = 1
+		} else {
+			s.position.Column++
+		}
+	}
+
+	s.i += n
+}
+
+func (s *scanner) report(issue Issue) {
+	s.issues = append(s.issues, issue)
+}
+
+// LintString checks text and returns every issue found, ordered by
+// position.
+func LintString(text string, lang Language) []Issue {
+	brackets := lang.Brackets
+	if brackets == "" {
+		brackets = "()[]{}"
+	}
+
+	openers := make(map[rune]bool)
+	matching := make(map[rune]rune)
+	pairs := []rune(brackets)
+	for i := 0; i+1 < len(pairs); i += 2 {
+		openers[pairs[i]] = true
+		matching[pairs[i+1]] = pairs[i]
+	}
+
+	s := &scanner{text: text, position: Position{1, 1}}
+	open := stack.New[openBracket]()
+
+	for s.i < len(text) {
+		rest := text[s.i:]
+
+		if s.skipLineComment(rest, lang) || s.skipBlockComment(rest, lang) || s.skipString(rest, lang) {
+			continue
+		}
+
+		r, size := utf8.DecodeRuneInString(rest)
+		switch {
+		case openers[r]:
+			open.Push(openBracket{r, s.position})
+		case matching[r] != 0:
+			top, ok := open.Peek()
+			switch {
+			case !ok:
+				s.report(Issue{Kind: Unopened, Position: s.position, Found: string(r)})
+			case top.bracket != matching[r]:
+				s.report(Issue{Kind: Mismatched, Position: s.position, Found: string(r),
+					Opening: string(top.bracket), OpeningPosition: top.position})
+				// drop the bracket it was checked against so a single
+				// typo does not cascade into an error for every line
+				open.Pop()
+			default:
+				open.Pop()
+			}
+		}
+
+		s.advance(size)
+	}
+
+	for o := range open.All() {
+		s.report(Issue{Kind: Unclosed, Position: o.position, Found: string(o.bracket)})
+	}
+
+	slices.SortStableFunc(s.issues, func(a, b Issue) int {
+		if a.Position.Line != b.Position.Line {
+			return a.Position.Line - b.Position.Line
+		}
+		return a.Position.Column - b.Position.Column
+	})
+
+	return s.issues
+}
+
+func (s *scanner) skipLineComment(rest string, lang Language) bool {
+	for _, start := range lang.LineComments {
+		if strings.HasPrefix(rest, start) {
+			end := strings.IndexByte(rest, '\n')
+			if end == -1 {
+				end = len(rest)
+			}
+
+			s.advance(end)
+			return true
+		}
+	}
+
+	return false
+}
+
+func (s *scanner) skipBlockComment(rest string, lang Language) bool {
+	for _, c := range lang.BlockComments {
+		if strings.HasPrefix(rest, c.Start) {
+			start := s.position
+			end := strings.Index(rest[len(c.Start):], c.End)
+			if end == -1 {
+				s.report(Issue{Kind: UnterminatedComment, Position: start, Found: c.Start})
+				s.advance(len(rest))
+			} else {
+				s.advance(len(c.Start) + end + len(c.End))
+			}
+
+			return true
+		}
+	}
+
+	return false
+}
+
+func (s *scanner) skipString(rest string, lang Language) bool {
+	for _, l := range lang.Strings {
+		if !strings.HasPrefix(rest, l.Delimiter) {
+			continue
+		}
+
+		start := s.position
+		s.advance(len(l.Delimiter))
+
+		for {
+			if s.i == len(s.text) {
+				s.report(Issue{Kind: UnterminatedString, Position: start, Found: l.Delimiter})
+				return true
+			}
+
+			rest = s.text[s.i:]
+			if strings.HasPrefix(rest, l.Delimiter) {
+				s.advance(len(l.Delimiter))
+				return true
+			}
+
+			r, size := utf8.DecodeRuneInString(rest)
+			if r == '\n' && !l.Multiline {
+				// leave the newline alone: the code resumes on the next line
+				s.report(Issue{Kind: UnterminatedString, Position: start, Found: l.Delimiter})
+				return true
+			}
+
+			if r == '\\' && !l.Raw && size < len(rest) {
+				_, escaped := utf8.DecodeRuneInString(rest[size:])
+				size += escaped
+			}
+
+			s.advance(size)
+		}
+	}
+
+	return false
+}
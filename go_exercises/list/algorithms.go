@@ -0,0 +1,194 @@
+package list
+
+import "cmp"
+
+// HasCycle reports whether following next pointers from head ever loops
+// back, using Floyd's tortoise and hare: the fast pointer moves two nodes
+// per step and the slow one a single node, so they can only meet inside a
+// cycle. O(N) time, O(1) space.
+func HasCycle[T any](head *Node[T]) bool {
+	return CycleStart(head) != nil
+}
+
+// CycleStart returns the first node of the cycle reachable from head, or
+// nil if there is none. Once the two pointers of Floyd's algorithm meet,
+// a pointer restarted from head and one left at the meeting point reach the
+// start of the cycle after the same number of steps.
+func CycleStart[T any](head *Node[T]) *Node[T] {
+	slow, fast := head, head
+	for fast != nil && fast.next != nil {
+		slow = slow.next
+		fast = fast.next.next
+
+		if slow == fast {
+			slow = head
+			for slow != fast {
+				slow = slow.next
+				fast = fast.next
+			}
+
+			return slow
+		}
+	}
+
+	return nil
+}
+
+// Middle returns the middle node, or the second of the two middle nodes
+// when the length is even. It walks the list once with a slow and a fast
+// pointer instead of relying on the stored length.
+func (list *List[T]) Middle() *Node[T] {
+	slow, fast := list.head, list.head
+	for fast != nil && fast.next != nil {
+		slow = slow.next
+		fast = fast.next.next
+		list.counter.Read()
+	}
+
+	return slow
+}
+
+// KthFromEnd returns the value k nodes from the end, where k = 1 is the
+// tail. A leading pointer starts k nodes ahead, so when it falls off the
+// end the trailing pointer is on the answer: one pass, O(1) space.
+func (list *List[T]) KthFromEnd(k int) (T, bool) {
+	var zero T
+	if k < 1 {
+		return zero, false
+	}
+
+	lead := list.head
+	for range k {
+		if lead == nil {
+			return zero, false
+		}
+		lead = lead.next
+		list.counter.Read()
+	}
+
+	trail := list.head
+	for lead != nil {
+		lead = lead.next
+		trail = trail.next
+		list.counter.Read()
+	}
+
+	return trail.Data, true
+}
+
+// Merge moves every node of the sorted lists a and b into a new sorted
+// list, leaving both empty. Nodes are relinked, not copied, and equal
+// values keep a's nodes first. Merging a list with itself returns it
+// unchanged.
+func Merge[T cmp.Ordered](a, b *List[T]) *List[T] {
+	return MergeFunc(a, b, cmp.Compare[T])
+}
+
+// MergeFunc is like Merge but orders values with compare. The merged list
+// reports its steps to a's counter, as does the merge itself.
+func MergeFunc[T any](a, b *List[T], compare func(x, y T) int) *List[T] {
+	// relinking a list into itself would close it into a cycle
+	if a == b {
+		return a
+	}
+
+	dummy := &Node[T]{}
+	tail := dummy
+	left, right := a.head, b.head
+	counter := a.counter
+
+	for left != nil && right != nil {
+		counter.Compare()
+		counter.Write()
+		if compare(right.Data, left.Data) < 0 {
+			tail.next = right
+			right = right.next
+		} else {
+			tail.next = left
+			left = left.next
+		}
+		tail = tail.next
+	}
+
+	// at most one list has nodes left, already in order
+	merged := &List[T]{length: a.length + b.length, counter: counter}
+	switch {
+	case left != nil:
+		tail.next = left
+		merged.tail = a.tail
+	case right != nil:
+		tail.next = right
+		merged.tail = b.tail
+	case tail != dummy:
+		merged.tail = tail
+	}
+	merged.head = dummy.next
+
+	*a = List[T]{}
+	*b = List[T]{}
+	return merged
+}
+
+// DedupeSorted removes repeated values from a sorted list in place. Since
+// duplicates are next to each other, comparing every node with the last
+// kept one is enough: O(N) time and O(1) space, unlike RemoveDuplicates,
+// which needs a hash table but works on unsorted lists.
+func DedupeSorted[T comparable](list *List[T]) {
+	current := list.head
+	if current == nil {
+		return
+	}
+
+	next := current.next
+	for next != nil {
+		list.counter.Read()
+		list.counter.Compare()
+		if current.Data == next.Data {
+			next = next.next
+			list.length--
+		} else {
+			current.next = next
+			current = next
+			next = current.next
+		}
+	}
+
+	current.next = nil
+	list.tail = current
+}
+
+// Partition rearranges the list so that every value less than x comes
+// before every value greater than or equal to x. Both halves keep their
+// original relative order.
+func Partition[T cmp.Ordered](list *List[T], x T) {
+	list.PartitionFunc(func(d T) bool { return d < x })
+}
+
+// PartitionFunc moves every node whose value satisfies before ahead of the
+// others, keeping the relative order within both groups.
+func (list *List[T]) PartitionFunc(before func(T) bool) {
+	beforeDummy, afterDummy := &Node[T]{}, &Node[T]{}
+	beforeTail, afterTail := beforeDummy, afterDummy
+
+	for current := list.head; current != nil; current = current.next {
+		list.counter.Read()
+		list.counter.Write()
+		if before(current.Data) {
+			beforeTail.next = current
+			beforeTail = current
+		} else {
+			afterTail.next = current
+			afterTail = current
+		}
+	}
+
+	afterTail.next = nil
+	beforeTail.next = afterDummy.next
+	list.head = beforeDummy.next
+
+	if afterTail != afterDummy {
+		list.tail = afterTail
+	} else if beforeTail != beforeDummy {
+		list.tail = beforeTail
+	}
+}
@@ -0,0 +1,192 @@
+package list
+
+import (
+	"slices"
+	"testing"
+)
+
+// checkList fails unless list holds want and its length and tail agree
+// with its nodes.
+func checkList[T comparable](t *testing.T, list *List[T], want []T) {
+	t.Helper()
+
+	if got := slices.Collect(list.All()); !slices.Equal(got, want) {
+		t.Fatalf("list = %v, want %v", got, want)
+	}
+	if list.Len() != len(want) {
+		t.Fatalf("Len() = %d, want %d", list.Len(), len(want))
+	}
+
+	switch {
+	case len(want) == 0 && list.Tail() != nil:
+		t.Fatalf("Tail() = %v, want nil", list.Tail().Data)
+	case len(want) > 0 && (list.Tail() == nil || list.Tail().Data != want[len(want)-1] || list.Tail().Next() != nil):
+		t.Fatalf("Tail() is not the last node")
+	}
+}
+
+func TestHasCycleAndCycleStart(t *testing.T) {
+	tests := []struct {
+		name   string
+		length int
+		// loopTo is the index the tail links back to, or -1
+		loopTo int
+	}{
+		{"empty", 0, -1},
+		{"single", 1, -1},
+		{"single self loop", 1, 0},
+		{"no cycle", 5, -1},
+		{"loop to head", 5, 0},
+		{"loop to middle", 6, 3},
+		{"loop to tail", 4, 3},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			nodes := make([]*Node[int], test.length)
+			for i := range nodes {
+				nodes[i] = &Node[int]{Data: i}
+				if i > 0 {
+					nodes[i-1].Link(nodes[i])
+				}
+			}
+
+			var head, want *Node[int]
+			if test.length > 0 {
+				head = nodes[0]
+			}
+			if test.loopTo >= 0 {
+				want = nodes[test.loopTo]
+				nodes[test.length-1].Link(want)
+			}
+
+			if got := HasCycle(head); got != (want != nil) {
+				t.Errorf("HasCycle() = %v, want %v", got, want != nil)
+			}
+			if got := CycleStart(head); got != want {
+				t.Errorf("CycleStart() = %v, want %v", got, want)
+			}
+		})
+	}
+}
+
+func TestMiddle(t *testing.T) {
+	tests := []struct {
+		values []int
+		want   int
+	}{
+		{[]int{1}, 1},
+		{[]int{1, 2}, 2},
+		{[]int{1, 2, 3}, 2},
+		{[]int{1, 2, 3, 4}, 3},
+		{[]int{1, 2, 3, 4, 5}, 3},
+	}
+
+	for _, test := range tests {
+		if got := New(test.values...).Middle(); got == nil || got.Data != test.want {
+			t.Errorf("Middle(%v) = %v, want %d", test.values, got, test.want)
+		}
+	}
+
+	if got := New[int]().Middle(); got != nil {
+		t.Errorf("Middle of empty list = %v, want nil", got)
+	}
+}
+
+func TestKthFromEnd(t *testing.T) {
+	list := New(1, 2, 3, 4, 5)
+	tests := []struct {
+		k    int
+		want int
+		ok   bool
+	}{
+		{1, 5, true},
+		{2, 4, true},
+		{5, 1, true},
+		{6, 0, false},
+		{0, 0, false},
+		{-1, 0, false},
+	}
+
+	for _, test := range tests {
+		got, ok := list.KthFromEnd(test.k)
+		if got != test.want || ok != test.ok {
+			t.Errorf("KthFromEnd(%d) = %d, %v, want %d, %v", test.k, got, ok, test.want, test.ok)
+		}
+	}
+	checkList(t, list, []int{1, 2, 3, 4, 5})
+}
+
+func TestMerge(t *testing.T) {
+	tests := []struct {
+		a, b, want []int
+	}{
+		{nil, nil, nil},
+		{[]int{1, 2}, nil, []int{1, 2}},
+		{nil, []int{1, 2}, []int{1, 2}},
+		{[]int{1, 3, 5}, []int{2, 4, 6}, []int{1, 2, 3, 4, 5, 6}},
+		{[]int{1, 2, 3}, []int{4, 5}, []int{1, 2, 3, 4, 5}},
+		{[]int{4, 5}, []int{1, 2, 3}, []int{1, 2, 3, 4, 5}},
+		{[]int{1, 1, 2}, []int{1, 2, 2}, []int{1, 1, 1, 2, 2, 2}},
+	}
+
+	for _, test := range tests {
+		a, b := New(test.a...), New(test.b...)
+		merged := Merge(a, b)
+		checkList(t, merged, test.want)
+		checkList(t, a, nil)
+		checkList(t, b, nil)
+
+		// the merged list must still accept insertions at its tail
+		merged.Insert(100)
+		checkList(t, merged, append(slices.Clone(test.want), 100))
+	}
+}
+
+func TestMergeWithItself(t *testing.T) {
+	list := New(1, 2, 3)
+	if got := Merge(list, list); got != list {
+		t.Fatalf("Merge(l, l) returned a different list")
+	}
+	checkList(t, list, []int{1, 2, 3})
+}
+
+func TestDedupeSorted(t *testing.T) {
+	tests := []struct {
+		values, want []int
+	}{
+		{nil, nil},
+		{[]int{1}, []int{1}},
+		{[]int{1, 1, 1}, []int{1}},
+		{[]int{1, 2, 3}, []int{1, 2, 3}},
+		{[]int{1, 1, 2, 3, 3}, []int{1, 2, 3}},
+		{[]int{1, 2, 2, 2}, []int{1, 2}},
+	}
+
+	for _, test := range tests {
+		list := New(test.values...)
+		DedupeSorted(list)
+		checkList(t, list, test.want)
+	}
+}
+
+func TestPartition(t *testing.T) {
+	tests := []struct {
+		values []int
+		x      int
+		want   []int
+	}{
+		{nil, 3, nil},
+		{[]int{3, 5, 8, 5, 10, 2, 1}, 5, []int{3, 2, 1, 5, 8, 5, 10}},
+		{[]int{1, 2, 3}, 10, []int{1, 2, 3}},
+		{[]int{1, 2, 3}, 0, []int{1, 2, 3}},
+		{[]int{5, 1}, 3, []int{1, 5}},
+		{[]int{1, 5}, 3, []int{1, 5}},
+	}
+
+	for _, test := range tests {
+		list := New(test.values...)
+		Partition(list, test.x)
+		checkList(t, list, test.want)
+	}
+}
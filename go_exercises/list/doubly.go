@@ -0,0 +1,240 @@
+package list
+
+import (
+	"iter"
+
+	"github.com/devluxor/common-sense-guide-to-dsa/go_exercises/steps"
+)
+
+// DoublyList is a doubly linked list. The zero value is an empty list ready
+// to use. Every node knows its neighbours, so inserting or removing at a
+// known node is O(1), which makes the list a good fit for deques, LRU
+// caches and schedulers.
+type DoublyList[T any] struct {
+	head    *DoublyNode[T]
+	tail    *DoublyNode[T]
+	length  int
+	counter *steps.Counter
+}
+
+// DoublyNode is a doubly linked list node. Nodes returned by a list's
+// insertion methods act as handles for the O(1) operations.
+type DoublyNode[T any] struct {
+	Data     T
+	previous *DoublyNode[T]
+	next     *DoublyNode[T]
+	list     *DoublyList[T]
+}
+
+// Next returns the node that follows n, or nil.
+func (n *DoublyNode[T]) Next() *DoublyNode[T] {
+	return n.next
+}
+
+// Previous returns the node that precedes n, or nil.
+func (n *DoublyNode[T]) Previous() *DoublyNode[T] {
+	return n.previous
+}
+
+// NewDoubly returns a doubly linked list holding values in order.
+func NewDoubly[T any](values ...T) *DoublyList[T] {
+	list := &DoublyList[T]{}
+	for _, v := range values {
+		list.PushBack(v)
+	}
+
+	return list
+}
+
+// Head returns the first node, or nil if the list is empty.
+func (list *DoublyList[T]) Head() *DoublyNode[T] {
+	return list.head
+}
+
+// Tail returns the last node, or nil if the list is empty.
+func (list *DoublyList[T]) Tail() *DoublyNode[T] {
+	return list.tail
+}
+
+// Len returns the number of nodes in the list.
+func (list *DoublyList[T]) Len() int {
+	return list.length
+}
+
+// SetCounter makes the list report its steps to c from now on: a read for
+// every node visited and a write for every node linked in or out. A nil c
+// stops counting.
+func (list *DoublyList[T]) SetCounter(c *steps.Counter) {
+	list.counter = c
+}
+
+// link inserts n between previous and next, either of which may be nil
+// when n becomes the new head or tail.
+func (list *DoublyList[T]) link(n, previous, next *DoublyNode[T]) {
+	n.previous = previous
+	n.next = next
+	n.list = list
+
+	if previous == nil {
+		list.head = n
+	} else {
+		previous.next = n
+	}
+
+	if next == nil {
+		list.tail = n
+	} else {
+		next.previous = n
+	}
+
+	list.length++
+	list.counter.Write()
+}
+
+// unlink detaches n from the list without touching its data.
+func (list *DoublyList[T]) unlink(n *DoublyNode[T]) {
+	if n.previous == nil {
+		list.head = n.next
+	} else {
+		n.previous.next = n.next
+	}
+
+	if n.next == nil {
+		list.tail = n.previous
+	} else {
+		n.next.previous = n.previous
+	}
+
+	n.previous = nil
+	n.next = nil
+	n.list = nil
+	list.length--
+	list.counter.Write()
+}
+
+// PushFront inserts d at the head of the list and returns its node.
+func (list *DoublyList[T]) PushFront(d T) *DoublyNode[T] {
+	n := &DoublyNode[T]{Data: d}
+	list.link(n, nil, list.head)
+	return n
+}
+
+// PushBack inserts d at the tail of the list and returns its node.
+func (list *DoublyList[T]) PushBack(d T) *DoublyNode[T] {
+	n := &DoublyNode[T]{Data: d}
+	list.link(n, list.tail, nil)
+	return n
+}
+
+// InsertBefore inserts d right before mark and returns its node. It
+// returns nil if mark does not belong to the list.
+func (list *DoublyList[T]) InsertBefore(d T, mark *DoublyNode[T]) *DoublyNode[T] {
+	if mark == nil || mark.list != list {
+		return nil
+	}
+
+	n := &DoublyNode[T]{Data: d}
+	list.link(n, mark.previous, mark)
+	return n
+}
+
+// InsertAfter inserts d right after mark and returns its node. It returns
+// nil if mark does not belong to the list.
+func (list *DoublyList[T]) InsertAfter(d T, mark *DoublyNode[T]) *DoublyNode[T] {
+	if mark == nil || mark.list != list {
+		return nil
+	}
+
+	n := &DoublyNode[T]{Data: d}
+	list.link(n, mark, mark.next)
+	return n
+}
+
+// Remove deletes n from the list in O(1) and reports whether n belonged to
+// it.
+func (list *DoublyList[T]) Remove(n *DoublyNode[T]) bool {
+	if n == nil || n.list != list {
+		return false
+	}
+
+	list.unlink(n)
+	return true
+}
+
+// MoveToFront moves n to the head of the list. It does nothing if n does
+// not belong to the list.
+func (list *DoublyList[T]) MoveToFront(n *DoublyNode[T]) {
+	if n == nil || n.list != list || list.head == n {
+		return
+	}
+
+	list.unlink(n)
+	list.link(n, nil, list.head)
+}
+
+// MoveToBack moves n to the tail of the list. It does nothing if n does
+// not belong to the list.
+func (list *DoublyList[T]) MoveToBack(n *DoublyNode[T]) {
+	if n == nil || n.list != list || list.tail == n {
+		return
+	}
+
+	list.unlink(n)
+	list.link(n, list.tail, nil)
+}
+
+// Splice moves every node of other to the tail of list, leaving other
+// empty. Relinking the two lists is O(1); handing the nodes over to list
+// costs O(M) in the length of other.
+func (list *DoublyList[T]) Splice(other *DoublyList[T]) {
+	if other == nil || other == list || other.head == nil {
+		return
+	}
+
+	for n := other.head; n != nil; n = n.next {
+		n.list = list
+	}
+
+	if list.tail == nil {
+		list.head = other.head
+	} else {
+		list.tail.next = other.head
+		other.head.previous = list.tail
+	}
+	list.tail = other.tail
+	list.length += other.length
+
+	other.head = nil
+	other.tail = nil
+	other.length = 0
+}
+
+// All returns an iterator over the values from head to tail.
+func (list *DoublyList[T]) All() iter.Seq[T] {
+	return func(yield func(T) bool) {
+		// read the neighbour first so the caller may remove the current node
+		for currentNode := list.head; currentNode != nil; {
+			next := currentNode.next
+			list.counter.Read()
+			if !yield(currentNode.Data) {
+				return
+			}
+			currentNode = next
+		}
+	}
+}
+
+// Backward returns an iterator over the values from tail to head.
+func (list *DoublyList[T]) Backward() iter.Seq[T] {
+	return func(yield func(T) bool) {
+		// read the neighbour first so the caller may remove the current node
+		for currentNode := list.tail; currentNode != nil; {
+			previous := currentNode.previous
+			list.counter.Read()
+			if !yield(currentNode.Data) {
+				return
+			}
+			currentNode = previous
+		}
+	}
+}
@@ -0,0 +1,187 @@
+// Package list provides singly and doubly linked lists.
+package list
+
+import (
+	"iter"
+
+	"github.com/devluxor/common-sense-guide-to-dsa/go_exercises/steps"
+)
+
+// List is a singly linked list that keeps track of both ends and of its
+// length. The zero value is an empty list ready to use, and every method
+// that rearranges nodes keeps head, tail and length up to date.
+type List[T any] struct {
+	head    *Node[T]
+	tail    *Node[T]
+	length  int
+	counter *steps.Counter
+}
+
+// Node is a singly linked list node.
+type Node[T any] struct {
+	Data T
+	next *Node[T]
+}
+
+// Next returns the node that follows n, or nil.
+func (n *Node[T]) Next() *Node[T] {
+	return n.next
+}
+
+// Link points n at next and returns next, so chains of loose nodes can be
+// built for the node-level algorithms such as HasCycle. Linking nodes that
+// belong to a List bypasses its bookkeeping.
+func (n *Node[T]) Link(next *Node[T]) *Node[T] {
+	n.next = next
+	return next
+}
+
+// New returns a list holding values in order.
+func New[T any](values ...T) *List[T] {
+	list := &List[T]{}
+	for _, v := range values {
+		list.Insert(v)
+	}
+
+	return list
+}
+
+// Head returns the first node, or nil if the list is empty.
+func (list *List[T]) Head() *Node[T] {
+	return list.head
+}
+
+// Tail returns the last node, or nil if the list is empty.
+func (list *List[T]) Tail() *Node[T] {
+	return list.tail
+}
+
+// Len returns the number of nodes in the list.
+func (list *List[T]) Len() int {
+	return list.length
+}
+
+// SetCounter makes the list report its steps to c from now on: a read for
+// every node visited and a write for every next pointer changed. A nil c
+// stops counting.
+func (list *List[T]) SetCounter(c *steps.Counter) {
+	list.counter = c
+}
+
+// Insert appends d at the end of the list.
+func (list *List[T]) Insert(d T) {
+	newNode := &Node[T]{Data: d}
+	list.length++
+	list.counter.Write()
+
+	if list.tail == nil {
+		list.head = newNode
+		list.tail = newNode
+		return
+	}
+
+	list.tail.next = newNode
+	list.tail = newNode
+}
+
+// All returns an iterator over the values from head to tail.
+func (list *List[T]) All() iter.Seq[T] {
+	return func(yield func(T) bool) {
+		for currentNode := list.head; currentNode != nil; currentNode = currentNode.next {
+			list.counter.Read()
+			if !yield(currentNode.Data) {
+				return
+			}
+		}
+	}
+}
+
+// Last returns the value at the tail in O(1).
+func (list *List[T]) Last() (T, bool) {
+	if list.tail == nil {
+		var zero T
+		return zero, false
+	}
+
+	return list.tail.Data, true
+}
+
+// LastNoTail returns the last value by walking from the head, which is
+// what Last would cost without a tail pointer: O(N).
+func (list *List[T]) LastNoTail() (T, bool) {
+	currentNode := list.head
+	if currentNode == nil {
+		var zero T
+		return zero, false
+	}
+
+	for currentNode.next != nil {
+		list.counter.Read()
+		currentNode = currentNode.next
+	}
+
+	return currentNode.Data, true
+}
+
+// RemoveFunc deletes every node whose value satisfies match.
+func (list *List[T]) RemoveFunc(match func(T) bool) {
+	dummyNode := &Node[T]{next: list.head}
+	previous := dummyNode
+	current := list.head
+
+	for current != nil {
+		list.counter.Read()
+		if match(current.Data) {
+			previous.next = current.next
+			list.length--
+			list.counter.Write()
+		} else {
+			previous = current
+		}
+
+		current = current.next
+	}
+
+	// a head, either the original or the new one if we removed the head
+	list.head = dummyNode.next
+	if previous == dummyNode {
+		list.tail = nil
+	} else {
+		list.tail = previous
+	}
+}
+
+// Reverse reverses the list in place.
+func (list *List[T]) Reverse() {
+	var previous *Node[T]
+	current := list.head
+	var next *Node[T]
+	for current != nil {
+		next = current.next
+		current.next = previous
+		list.counter.Read()
+		list.counter.Write()
+		previous = current
+		current = next
+	}
+
+	list.head, list.tail = previous, list.head
+}
+
+// Remove deletes every node holding target.
+func Remove[T comparable](list *List[T], target T) {
+	list.RemoveFunc(func(d T) bool { return d == target })
+}
+
+// RemoveDuplicates keeps only the first occurrence of every value.
+func RemoveDuplicates[T comparable](list *List[T]) {
+	seen := make(map[T]bool)
+	list.RemoveFunc(func(d T) bool {
+		if seen[d] {
+			return true
+		}
+
+		seen[d] = true
+		return false
+	})
+}
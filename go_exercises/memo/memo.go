@@ -0,0 +1,171 @@
+// Package memo memoizes functions, recursive ones in particular, so that
+// every distinct argument is computed only once.
+//
+// A recursive function is written against a self parameter and calls it
+// instead of itself; the memo hands it its own cached entry point:
+//
+//	golomb := memo.Recursive(func(self func(int) int, n int) int {
+//		if n == 1 {
+//			return 1
+//		}
+//		return 1 + self(n-self(self(n-1)))
+//	}, memo.Options{})
+//	golomb.Call(1000)
+//
+// Functions of two or three arguments are keyed by a tuple struct instead
+// of packing the arguments into a single integer by hand.
+package memo
+
+import (
+	"sync"
+
+	"github.com/devluxor/common-sense-guide-to-dsa/go_exercises/cache"
+)
+
+// Eviction chooses which result a bounded memo forgets to make room.
+type Eviction int
+
+const (
+	// LRU forgets the least recently used result.
+	LRU Eviction = iota
+	// LFU forgets the least frequently used result.
+	LFU
+)
+
+// Options configures a memo. The zero value remembers every result and is
+// not safe for concurrent use.
+type Options struct {
+	// MaxEntries, when positive, bounds how many results are kept.
+	MaxEntries int
+	// Eviction picks the result to forget once MaxEntries is reached.
+	Eviction Eviction
+	// Concurrent makes the memo safe for use by several goroutines. The
+	// lock is not held while the function runs, so two goroutines asking
+	// for the same missing key may both compute it.
+	Concurrent bool
+}
+
+// Stats counts how lookups went, as for the caches it is built on.
+type Stats = cache.Stats
+
+// store is the part of the caches' API a memo needs.
+type store[K comparable, V any] interface {
+	Get(key K) (V, bool)
+	Put(key K, value V)
+	Len() int
+	Stats() Stats
+}
+
+// unbounded is a store that never forgets.
+type unbounded[K comparable, V any] struct {
+	values map[K]V
+	stats  Stats
+}
+
+func (u *unbounded[K, V]) Get(key K) (V, bool) {
+	v, ok := u.values[key]
+	if ok {
+		u.stats.Hits++
+	} else {
+		u.stats.Misses++
+	}
+
+	return v, ok
+}
+
+func (u *unbounded[K, V]) Put(key K, value V) {
+	u.values[key] = value
+}
+
+func (u *unbounded[K, V]) Len() int {
+	return len(u.values)
+}
+
+func (u *unbounded[K, V]) Stats() Stats {
+	return u.stats
+}
+
+func newStore[K comparable, V any](opts Options) store[K, V] {
+	switch {
+	case opts.MaxEntries <= 0:
+		return &unbounded[K, V]{values: make(map[K]V)}
+	case opts.Eviction == LFU:
+		return cache.NewLFU[K, V](opts.MaxEntries, nil)
+	}
+
+	return cache.NewLRU[K, V](opts.MaxEntries, nil)
+}
+
+// Memo is a function of one comparable argument with its results cached.
+type Memo[K comparable, V any] struct {
+	f       func(self func(K) V, key K) V
+	opts    Options
+	mu      sync.Mutex
+	results store[K, V]
+}
+
+// New memoizes f.
+func New[K comparable, V any](f func(K) V, opts Options) *Memo[K, V] {
+	return Recursive(func(_ func(K) V, key K) V { return f(key) }, opts)
+}
+
+// Recursive memoizes f, which makes its recursive calls through self so
+// that they are memoized too.
+func Recursive[K comparable, V any](f func(self func(K) V, key K) V, opts Options) *Memo[K, V] {
+	return &Memo[K, V]{f: f, opts: opts, results: newStore[K, V](opts)}
+}
+
+// Call returns f(key), computing it only if it is not cached.
+func (m *Memo[K, V]) Call(key K) V {
+	m.lock()
+	v, ok := m.results.Get(key)
+	m.unlock()
+	if ok {
+		return v
+	}
+
+	v = m.f(m.Call, key)
+
+	m.lock()
+	m.results.Put(key, v)
+	m.unlock()
+	return v
+}
+
+// Func returns Call as a plain function value.
+func (m *Memo[K, V]) Func() func(K) V {
+	return m.Call
+}
+
+// Len returns the number of cached results.
+func (m *Memo[K, V]) Len() int {
+	m.lock()
+	defer m.unlock()
+	return m.results.Len()
+}
+
+// Stats returns the hit, miss and eviction counts so far.
+func (m *Memo[K, V]) Stats() Stats {
+	m.lock()
+	defer m.unlock()
+	return m.results.Stats()
+}
+
+// Reset forgets every result and zeroes the statistics.
+func (m *Memo[K, V]) Reset() {
+	m.lock()
+	defer m.unlock()
+	m.results = newStore[K, V](m.opts)
+}
+
+func (m *Memo[K, V]) lock() {
+	if m.opts.Concurrent {
+		m.mu.Lock()
+	}
+}
+
+func (m *Memo[K, V]) unlock() {
+	if m.opts.Concurrent {
+		m.mu.Unlock()
+	}
+}
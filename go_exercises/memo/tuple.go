@@ -0,0 +1,67 @@
+package memo
+
+// Key2 is the cache key of a memoized function of two arguments.
+type Key2[A, B comparable] struct {
+	A A
+	B B
+}
+
+// Key3 is the cache key of a memoized function of three arguments.
+type Key3[A, B, C comparable] struct {
+	A A
+	B B
+	C C
+}
+
+// Memo2 is a function of two comparable arguments with its results cached.
+type Memo2[A, B comparable, V any] struct {
+	*Memo[Key2[A, B], V]
+}
+
+// Recursive2 memoizes f, a function of two arguments that makes its
+// recursive calls through self.
+func Recursive2[A, B comparable, V any](f func(self func(A, B) V, a A, b B) V, opts Options) *Memo2[A, B, V] {
+	m := &Memo2[A, B, V]{}
+	m.Memo = Recursive(func(_ func(Key2[A, B]) V, k Key2[A, B]) V {
+		return f(m.Call, k.A, k.B)
+	}, opts)
+
+	return m
+}
+
+// Call returns f(a, b), computing it only if it is not cached.
+func (m *Memo2[A, B, V]) Call(a A, b B) V {
+	return m.Memo.Call(Key2[A, B]{a, b})
+}
+
+// Func returns Call as a plain function value.
+func (m *Memo2[A, B, V]) Func() func(A, B) V {
+	return m.Call
+}
+
+// Memo3 is a function of three comparable arguments with its results
+// cached.
+type Memo3[A, B, C comparable, V any] struct {
+	*Memo[Key3[A, B, C], V]
+}
+
+// Recursive3 memoizes f, a function of three arguments that makes its
+// recursive calls through self.
+func Recursive3[A, B, C comparable, V any](f func(self func(A, B, C) V, a A, b B, c C) V, opts Options) *Memo3[A, B, C, V] {
+	m := &Memo3[A, B, C, V]{}
+	m.Memo = Recursive(func(_ func(Key3[A, B, C]) V, k Key3[A, B, C]) V {
+		return f(m.Call, k.A, k.B, k.C)
+	}, opts)
+
+	return m
+}
+
+// Call returns f(a, b, c), computing it only if it is not cached.
+func (m *Memo3[A, B, C, V]) Call(a A, b B, c C) V {
+	return m.Memo.Call(Key3[A, B, C]{a, b, c})
+}
+
+// Func returns Call as a plain function value.
+func (m *Memo3[A, B, C, V]) Func() func(A, B, C) V {
+	return m.Call
+}
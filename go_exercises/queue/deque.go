@@ -0,0 +1,152 @@
+// Package queue provides a FIFO queue and a double-ended queue, both backed
+// by a ring buffer: a slice used circularly, so adding or removing at either
+// end is O(1) amortized and no item is ever shifted.
+package queue
+
+import "iter"
+
+// minCapacity is the smallest ring allocated once the deque holds items.
+const minCapacity = 8
+
+// Deque is a double-ended queue. The zero value is an empty deque ready to
+// use. The ring doubles when full and halves when a quarter full, so memory
+// is reclaimed as items are removed.
+type Deque[T any] struct {
+	buf    []T
+	head   int
+	length int
+}
+
+// NewDeque returns an empty deque.
+func NewDeque[T any]() *Deque[T] {
+	return &Deque[T]{}
+}
+
+// index maps the i-th item, counting from the front, to its slot.
+func (d *Deque[T]) index(i int) int {
+	return (d.head + i) % len(d.buf)
+}
+
+// resize copies the items, front first, into a ring of the given capacity.
+func (d *Deque[T]) resize(capacity int) {
+	buf := make([]T, capacity)
+	if d.head+d.length <= len(d.buf) {
+		copy(buf, d.buf[d.head:d.head+d.length])
+	} else {
+		n := copy(buf, d.buf[d.head:])
+		copy(buf[n:], d.buf[:d.length-n])
+	}
+
+	d.buf = buf
+	d.head = 0
+}
+
+func (d *Deque[T]) grow() {
+	if d.length == len(d.buf) {
+		d.resize(max(minCapacity, 2*len(d.buf)))
+	}
+}
+
+func (d *Deque[T]) shrink() {
+	if len(d.buf) > minCapacity && d.length <= len(d.buf)/4 {
+		d.resize(len(d.buf) / 2)
+	}
+}
+
+// PushFront adds v at the front.
+func (d *Deque[T]) PushFront(v T) {
+	d.grow()
+	d.head = (d.head - 1 + len(d.buf)) % len(d.buf)
+	d.buf[d.head] = v
+	d.length++
+}
+
+// PushBack adds v at the back.
+func (d *Deque[T]) PushBack(v T) {
+	d.grow()
+	d.buf[d.index(d.length)] = v
+	d.length++
+}
+
+// PopFront removes and returns the item at the front.
+func (d *Deque[T]) PopFront() (T, bool) {
+	var zero T
+	if d.length == 0 {
+		return zero, false
+	}
+
+	v := d.buf[d.head]
+	// clear the slot so the deque does not keep the item alive
+	d.buf[d.head] = zero
+	d.head = d.index(1)
+	d.length--
+	d.shrink()
+	return v, true
+}
+
+// PopBack removes and returns the item at the back.
+func (d *Deque[T]) PopBack() (T, bool) {
+	var zero T
+	if d.length == 0 {
+		return zero, false
+	}
+
+	i := d.index(d.length - 1)
+	v := d.buf[i]
+	d.buf[i] = zero
+	d.length--
+	d.shrink()
+	return v, true
+}
+
+// Front returns the item at the front without removing it.
+func (d *Deque[T]) Front() (T, bool) {
+	if d.length == 0 {
+		var zero T
+		return zero, false
+	}
+
+	return d.buf[d.head], true
+}
+
+// Back returns the item at the back without removing it.
+func (d *Deque[T]) Back() (T, bool) {
+	if d.length == 0 {
+		var zero T
+		return zero, false
+	}
+
+	return d.buf[d.index(d.length-1)], true
+}
+
+// Len returns the number of items.
+func (d *Deque[T]) Len() int {
+	return d.length
+}
+
+// Clear removes every item and releases the ring.
+func (d *Deque[T]) Clear() {
+	*d = Deque[T]{}
+}
+
+// All returns an iterator over the items from front to back.
+func (d *Deque[T]) All() iter.Seq[T] {
+	return func(yield func(T) bool) {
+		for i := 0; i < d.length; i++ {
+			if !yield(d.buf[d.index(i)]) {
+				return
+			}
+		}
+	}
+}
+
+// Backward returns an iterator over the items from back to front.
+func (d *Deque[T]) Backward() iter.Seq[T] {
+	return func(yield func(T) bool) {
+		for i := d.length - 1; i >= 0; i-- {
+			if !yield(d.buf[d.index(i)]) {
+				return
+			}
+		}
+	}
+}
@@ -0,0 +1,45 @@
+package queue
+
+import "iter"
+
+// Queue is a first-in, first-out collection: items are enqueued at the back
+// and dequeued from the front. The zero value is an empty queue ready to use.
+type Queue[T any] struct {
+	items Deque[T]
+}
+
+// New returns an empty queue.
+func New[T any]() *Queue[T] {
+	return &Queue[T]{}
+}
+
+// Enqueue adds v at the back of the queue.
+func (q *Queue[T]) Enqueue(v T) {
+	q.items.PushBack(v)
+}
+
+// Dequeue removes and returns the item at the front of the queue.
+func (q *Queue[T]) Dequeue() (T, bool) {
+	return q.items.PopFront()
+}
+
+// Peek returns the item at the front without removing it.
+func (q *Queue[T]) Peek() (T, bool) {
+	return q.items.Front()
+}
+
+// Len returns the number of items.
+func (q *Queue[T]) Len() int {
+	return q.items.Len()
+}
+
+// Clear removes every item and releases the ring.
+func (q *Queue[T]) Clear() {
+	q.items.Clear()
+}
+
+// All returns an iterator over the items in the order they will be
+// dequeued.
+func (q *Queue[T]) All() iter.Seq[T] {
+	return q.items.All()
+}
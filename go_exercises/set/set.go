@@ -0,0 +1,179 @@
+// Package set provides a hash set that remembers insertion order, with the
+// usual set algebra.
+package set
+
+import (
+	"iter"
+	"slices"
+
+	"github.com/devluxor/common-sense-guide-to-dsa/go_exercises/list"
+)
+
+// Set is a collection of distinct items. Lookups go through a hash table in
+// O(1), and the items are also kept in a doubly linked list so that
+// iteration, and the results of the set operations, follow the order in
+// which items were first added. The zero value is an empty set ready to
+// use.
+type Set[T comparable] struct {
+	items list.DoublyList[T]
+	nodes map[T]*list.DoublyNode[T]
+}
+
+// New returns a set holding items, in order, without repeats.
+func New[T comparable](items ...T) *Set[T] {
+	s := &Set[T]{}
+	for _, item := range items {
+		s.Add(item)
+	}
+
+	return s
+}
+
+// Add inserts item and reports whether it was not already in the set.
+func (s *Set[T]) Add(item T) bool {
+	if s.nodes == nil {
+		s.nodes = make(map[T]*list.DoublyNode[T])
+	}
+	if _, ok := s.nodes[item]; ok {
+		return false
+	}
+
+	s.nodes[item] = s.items.PushBack(item)
+	return true
+}
+
+// Remove deletes item and reports whether it was in the set.
+func (s *Set[T]) Remove(item T) bool {
+	node, ok := s.nodes[item]
+	if !ok {
+		return false
+	}
+
+	s.items.Remove(node)
+	delete(s.nodes, item)
+	return true
+}
+
+// Contains reports whether item is in the set.
+func (s *Set[T]) Contains(item T) bool {
+	_, ok := s.nodes[item]
+	return ok
+}
+
+// Len returns the number of items.
+func (s *Set[T]) Len() int {
+	return s.items.Len()
+}
+
+// Clear removes every item.
+func (s *Set[T]) Clear() {
+	*s = Set[T]{}
+}
+
+// Clone returns a copy of s with the same order.
+func (s *Set[T]) Clone() *Set[T] {
+	clone := &Set[T]{}
+	for item := range s.All() {
+		clone.Add(item)
+	}
+
+	return clone
+}
+
+// All returns an iterator over the items in insertion order.
+func (s *Set[T]) All() iter.Seq[T] {
+	return s.items.All()
+}
+
+// Items returns the items in insertion order.
+func (s *Set[T]) Items() []T {
+	return slices.Collect(s.All())
+}
+
+// Union returns the items in either set: those of s, then those only in
+// other.
+func (s *Set[T]) Union(other *Set[T]) *Set[T] {
+	union := s.Clone()
+	for item := range other.All() {
+		union.Add(item)
+	}
+
+	return union
+}
+
+// Intersection returns the items of s that are also in other, in the order
+// of s.
+func (s *Set[T]) Intersection(other *Set[T]) *Set[T] {
+	return s.filter(other.Contains)
+}
+
+// Difference returns the items of s that are not in other, in the order of
+// s.
+func (s *Set[T]) Difference(other *Set[T]) *Set[T] {
+	return s.filter(func(item T) bool { return !other.Contains(item) })
+}
+
+// SymmetricDifference returns the items in exactly one of the sets: those
+// only in s, then those only in other.
+func (s *Set[T]) SymmetricDifference(other *Set[T]) *Set[T] {
+	result := s.Difference(other)
+	for item := range other.All() {
+		if !s.Contains(item) {
+			result.Add(item)
+		}
+	}
+
+	return result
+}
+
+// IsSubset reports whether every item of s is in other.
+func (s *Set[T]) IsSubset(other *Set[T]) bool {
+	if s.Len() > other.Len() {
+		return false
+	}
+
+	for item := range s.All() {
+		if !other.Contains(item) {
+			return false
+		}
+	}
+
+	return true
+}
+
+// IsSuperset reports whether every item of other is in s.
+func (s *Set[T]) IsSuperset(other *Set[T]) bool {
+	return other.IsSubset(s)
+}
+
+// IsDisjoint reports whether the sets have no item in common.
+func (s *Set[T]) IsDisjoint(other *Set[T]) bool {
+	small, large := s, other
+	if small.Len() > large.Len() {
+		small, large = large, small
+	}
+
+	for item := range small.All() {
+		if large.Contains(item) {
+			return false
+		}
+	}
+
+	return true
+}
+
+// Equal reports whether both sets hold the same items, in any order.
+func (s *Set[T]) Equal(other *Set[T]) bool {
+	return s.Len() == other.Len() && s.IsSubset(other)
+}
+
+func (s *Set[T]) filter(keep func(T) bool) *Set[T] {
+	result := &Set[T]{}
+	for item := range s.All() {
+		if keep(item) {
+			result.Add(item)
+		}
+	}
+
+	return result
+}
@@ -0,0 +1,41 @@
+package set
+
+import "github.com/devluxor/common-sense-guide-to-dsa/go_exercises/counter"
+
+// Semantics chooses how Intersect treats repeated values.
+type Semantics int
+
+const (
+	// Distinct treats the slices as sets: every common value appears once
+	// in the result.
+	Distinct Semantics = iota
+	// Bag treats the slices as multisets: a value repeated m times in one
+	// slice and n times in the other appears min(m, n) times.
+	Bag
+)
+
+// Intersect returns the values that appear in both a and b, in the order
+// they appear in a. It hashes b once and walks a once: O(N + M).
+func Intersect[T comparable](a, b []T, semantics Semantics) []T {
+	output := []T{}
+	if semantics == Bag {
+		remaining := counter.New(b...)
+		for _, v := range a {
+			if remaining.Remove(v) {
+				output = append(output, v)
+			}
+		}
+
+		return output
+	}
+
+	remaining := New(b...)
+	for _, v := range a {
+		// removing the value stops it from being added twice
+		if remaining.Remove(v) {
+			output = append(output, v)
+		}
+	}
+
+	return output
+}
@@ -0,0 +1,102 @@
+package sorting
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/devluxor/common-sense-guide-to-dsa/go_exercises/internal/constraints"
+)
+
+// Integer is satisfied by every integer type.
+type Integer = constraints.Integer
+
+// MaxCountingRange is the largest key range, hi - lo + 1, the counting
+// sorts accept: beyond it the table of counts would dwarf the input.
+const MaxCountingRange = 1 << 24
+
+var (
+	// ErrOutOfRange is returned when a key falls outside the given range.
+	ErrOutOfRange = errors.New("sorting: key out of range")
+	// ErrRangeTooLarge is returned when the key range exceeds
+	// MaxCountingRange.
+	ErrRangeTooLarge = errors.New("sorting: key range too large for counting sort")
+)
+
+// offset returns k - lo as an unsigned distance. Unsigned arithmetic wraps,
+// so the result is right for signed and unsigned types alike, whenever
+// lo <= k.
+func offset[K Integer](k, lo K) uint64 {
+	return uint64(k) - uint64(lo)
+}
+
+// CountingSort returns the items of s in ascending order. It derives the
+// range of keys from the smallest and greatest items and counts how many
+// times each key appears, like the temperature readings exercise from
+// chapter 20: O(N + M) for a range of M keys.
+func CountingSort[T Integer](s []T) ([]T, error) {
+	return CountingSortBy(s, identity[T])
+}
+
+// CountingSortRange is like CountingSort for keys known to lie between lo
+// and hi inclusive. It returns ErrOutOfRange if any item is outside them.
+func CountingSortRange[T Integer](s []T, lo, hi T) ([]T, error) {
+	return CountingSortByRange(s, identity[T], lo, hi)
+}
+
+// CountingSortBy returns records sorted by the integer key extracted from
+// each of them. The sort is stable. The key range is derived from the
+// records.
+func CountingSortBy[R any, K Integer](records []R, key func(R) K) ([]R, error) {
+	if len(records) == 0 {
+		return []R{}, nil
+	}
+
+	lo, hi := key(records[0]), key(records[0])
+	for _, r := range records[1:] {
+		k := key(r)
+		lo = min(lo, k)
+		hi = max(hi, k)
+	}
+
+	return CountingSortByRange(records, key, lo, hi)
+}
+
+// CountingSortByRange is like CountingSortBy for keys known to lie between
+// lo and hi inclusive. It returns ErrOutOfRange if any key is outside them.
+func CountingSortByRange[R any, K Integer](records []R, key func(R) K, lo, hi K) ([]R, error) {
+	if hi < lo {
+		return nil, fmt.Errorf("%w: empty range [%v, %v]", ErrOutOfRange, lo, hi)
+	}
+	if offset(hi, lo) >= MaxCountingRange {
+		return nil, fmt.Errorf("%w: [%v, %v]", ErrRangeTooLarge, lo, hi)
+	}
+
+	counts := make([]int, offset(hi, lo)+1)
+	for _, r := range records {
+		k := key(r)
+		if k < lo || k > hi {
+			return nil, fmt.Errorf("%w: %v not in [%v, %v]", ErrOutOfRange, k, lo, hi)
+		}
+		counts[offset(k, lo)]++
+	}
+
+	// turn the counts into the index where each key's run starts
+	start := 0
+	for i, c := range counts {
+		counts[i] = start
+		start += c
+	}
+
+	sorted := make([]R, len(records))
+	for _, r := range records {
+		i := offset(key(r), lo)
+		sorted[counts[i]] = r
+		counts[i]++
+	}
+
+	return sorted, nil
+}
+
+func identity[T any](v T) T {
+	return v
+}
@@ -0,0 +1,141 @@
+package sorting
+
+import (
+	"cmp"
+	"math/bits"
+)
+
+// insertionThreshold is the length below which ranges are finished with
+// insertion sort, which beats quicksort on a handful of items.
+const insertionThreshold = 12
+
+// Sort sorts s in place in ascending order. See SortFunc.
+func Sort[T cmp.Ordered](s []T) {
+	SortFunc(s, cmp.Compare[T])
+}
+
+// SortFunc sorts s in place in the order given by compare, which returns a
+// negative number when a < b, zero when a == b and a positive number when
+// a > b. The sort is not stable.
+//
+// It is an introsort: quicksort with a median-of-three pivot and three-way
+// (Dutch national flag) partitioning, so runs of equal items are settled in
+// a single pass, insertion sort for short ranges, and heapsort once the
+// recursion gets deeper than 2·log2(N), which caps the worst case at
+// O(N log N) instead of quicksort's O(N²).
+func SortFunc[T any](s []T, compare func(a, b T) int) {
+	if len(s) < 2 {
+		return
+	}
+
+	introsort(s, compare, 2*bits.Len(uint(len(s))))
+}
+
+func introsort[T any](s []T, compare func(a, b T) int, depth int) {
+	for len(s) > insertionThreshold {
+		if depth == 0 {
+			heapsort(s, compare)
+			return
+		}
+		depth--
+
+		lt, gt := partition3(s, medianOfThree(s, compare), compare)
+
+		// recurse into the smaller side and loop over the larger one, so
+		// the call stack never grows beyond O(log N)
+		if lt < len(s)-gt {
+			introsort(s[:lt], compare, depth)
+			s = s[gt:]
+		} else {
+			introsort(s[gt:], compare, depth)
+			s = s[:lt]
+		}
+	}
+
+	insertionSort(s, compare)
+}
+
+// medianOfThree returns the median of the first, middle and last items,
+// which avoids the worst case on already sorted or reversed input.
+func medianOfThree[T any](s []T, compare func(a, b T) int) T {
+	a, b, c := s[0], s[len(s)/2], s[len(s)-1]
+	if compare(b, a) < 0 {
+		a, b = b, a
+	}
+	if compare(c, b) < 0 {
+		b = c
+		if compare(b, a) < 0 {
+			b = a
+		}
+	}
+
+	return b
+}
+
+// partition3 rearranges s into items less than, equal to and greater than
+// pivot, and returns lt and gt such that s[:lt] < pivot, s[lt:gt] == pivot
+// and s[gt:] > pivot.
+func partition3[T any](s []T, pivot T, compare func(a, b T) int) (lt, gt int) {
+	lt, gt = 0, len(s)
+
+	for i := 0; i < gt; {
+		switch c := compare(s[i], pivot); {
+		case c < 0:
+			s[lt], s[i] = s[i], s[lt]
+			lt++
+			i++
+		case c > 0:
+			gt--
+			s[i], s[gt] = s[gt], s[i]
+		default:
+			i++
+		}
+	}
+
+	return lt, gt
+}
+
+func insertionSort[T any](s []T, compare func(a, b T) int) {
+	for i := 1; i < len(s); i++ {
+		temp := s[i]
+		j := i - 1
+		for j >= 0 && compare(s[j], temp) > 0 {
+			s[j+1] = s[j]
+			j--
+		}
+		s[j+1] = temp
+	}
+}
+
+// heapsort builds a max-heap in place, then repeatedly swaps its root to
+// the end of the unsorted part: O(N log N) whatever the input.
+func heapsort[T any](s []T, compare func(a, b T) int) {
+	for i := len(s)/2 - 1; i >= 0; i-- {
+		siftDown(s, i, len(s), compare)
+	}
+
+	for end := len(s) - 1; end > 0; end-- {
+		s[0], s[end] = s[end], s[0]
+		siftDown(s, 0, end, compare)
+	}
+}
+
+// siftDown moves s[root] down the heap held in s[:end] until neither child
+// is greater.
+func siftDown[T any](s []T, root, end int, compare func(a, b T) int) {
+	for {
+		child := 2*root + 1
+		if child >= end {
+			return
+		}
+		if child+1 < end && compare(s[child], s[child+1]) < 0 {
+			child++
+		}
+		if compare(s[root], s[child]) >= 0 {
+			return
+		}
+
+		s[root], s[child] = s[child], s[root]
+		root = child
+	}
+}
@@ -0,0 +1,91 @@
+package sorting
+
+import "cmp"
+
+// MergeSort sorts s in ascending order. See MergeSortFunc.
+func MergeSort[T cmp.Ordered](s []T) {
+	MergeSortFunc(s, cmp.Compare[T])
+}
+
+// MergeSortFunc sorts s in the order given by compare with a top-down
+// merge sort: split the slice in halves, sort each recursively and merge
+// them. It is stable, so equal items keep their original order, and takes
+// O(N log N) time whatever the input, at the cost of an O(N) buffer.
+func MergeSortFunc[T any](s []T, compare func(a, b T) int) {
+	if len(s) < 2 {
+		return
+	}
+
+	buf := make([]T, len(s))
+	mergeSort(s, buf, compare)
+}
+
+func mergeSort[T any](s, buf []T, compare func(a, b T) int) {
+	if len(s) <= insertionThreshold {
+		// insertion sort is stable too, and faster on short ranges
+		insertionSort(s, compare)
+		return
+	}
+
+	mid := len(s) / 2
+	mergeSort(s[:mid], buf[:mid], compare)
+	mergeSort(s[mid:], buf[mid:], compare)
+
+	// already in order: nothing to merge
+	if compare(s[mid-1], s[mid]) <= 0 {
+		return
+	}
+
+	copy(buf, s)
+	merge(s, buf[:mid], buf[mid:len(s)], compare)
+}
+
+// merge writes the sorted runs left and right into dst. On ties the item
+// from left goes first, which is what keeps the sort stable.
+func merge[T any](dst, left, right []T, compare func(a, b T) int) {
+	i, j, k := 0, 0, 0
+	for i < len(left) && j < len(right) {
+		if compare(right[j], left[i]) < 0 {
+			dst[k] = right[j]
+			j++
+		} else {
+			dst[k] = left[i]
+			i++
+		}
+		k++
+	}
+
+	k += copy(dst[k:], left[i:])
+	copy(dst[k:], right[j:])
+}
+
+// MergeSortBottomUp sorts s in ascending order. See
+// MergeSortBottomUpFunc.
+func MergeSortBottomUp[T cmp.Ordered](s []T) {
+	MergeSortBottomUpFunc(s, cmp.Compare[T])
+}
+
+// MergeSortBottomUpFunc is a stable merge sort without recursion: it
+// merges runs of width 1, 2, 4 and so on, bouncing between s and a buffer,
+// until a single run covers the whole slice.
+func MergeSortBottomUpFunc[T any](s []T, compare func(a, b T) int) {
+	n := len(s)
+	if n < 2 {
+		return
+	}
+
+	src, dst := s, make([]T, n)
+	for width := 1; width < n; width *= 2 {
+		for low := 0; low < n; low += 2 * width {
+			mid := min(low+width, n)
+			high := min(low+2*width, n)
+			merge(dst[low:high], src[low:mid], src[mid:high], compare)
+		}
+		src, dst = dst, src
+	}
+
+	// after an odd number of passes the result sits in the buffer
+	if &src[0] != &s[0] {
+		copy(s, src)
+	}
+}
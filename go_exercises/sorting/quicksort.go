@@ -0,0 +1,71 @@
+// Package sorting provides sorting algorithms over generic slices.
+package sorting
+
+import (
+	"cmp"
+
+	"github.com/devluxor/common-sense-guide-to-dsa/go_exercises/steps"
+)
+
+// Quicksort sorts numbers in place in ascending order with the plain
+// quicksort from the book. Prefer Sort, which guards against the O(N²)
+// worst case.
+func Quicksort[T cmp.Ordered](numbers []T) {
+	QuicksortCounted(numbers, nil)
+}
+
+// QuicksortCounted is Quicksort reporting its comparisons and swaps to c.
+func QuicksortCounted[T cmp.Ordered](numbers []T, c *steps.Counter) {
+	if len(numbers) < 2 {
+		return
+	}
+
+	quicksort(numbers, 0, len(numbers)-1, c)
+}
+
+func quicksort[T cmp.Ordered](numbers []T, low, high int, c *steps.Counter) {
+	pivotIndex := PartitionCounted(numbers, low, high, c)
+	if low < pivotIndex-1 {
+		quicksort(numbers, low, pivotIndex-1, c)
+	}
+	if pivotIndex < high {
+		quicksort(numbers, pivotIndex, high, c)
+	}
+}
+
+// Partition rearranges numbers[low:high+1] around its middle element and
+// returns the index where the right-hand part starts: every value before it
+// is <= the pivot and every value from it on is >= the pivot.
+func Partition[T cmp.Ordered](numbers []T, low, high int) int {
+	return PartitionCounted(numbers, low, high, nil)
+}
+
+// PartitionCounted is Partition reporting its comparisons and swaps to c.
+func PartitionCounted[T cmp.Ordered](numbers []T, low, high int, c *steps.Counter) int {
+	pivot := numbers[low+(high-low)/2]
+	left := low
+	right := high
+
+	for left <= right {
+		for c.Compare(); numbers[left] < pivot; c.Compare() {
+			left++
+		}
+
+		for c.Compare(); numbers[right] > pivot; c.Compare() {
+			right--
+		}
+
+		if left > right {
+			break
+		}
+
+		// swap values at left & right pointers
+		numbers[left], numbers[right] = numbers[right], numbers[left]
+		c.Swap()
+
+		left++
+		right--
+	}
+
+	return left
+}
@@ -0,0 +1,109 @@
+package sorting
+
+import "math/bits"
+
+// RadixSort sorts s in place in ascending order with an LSD (least
+// significant digit first) radix sort: one stable counting pass per byte
+// of the keys, for O(N·B) time where B is the number of bytes needed to
+// tell the smallest key from the greatest.
+func RadixSort[T Integer](s []T) {
+	RadixSortBy(s, identity[T])
+}
+
+// RadixSortBy sorts records in place by the integer key extracted from
+// each of them. The sort is stable.
+func RadixSortBy[R any, K Integer](records []R, key func(R) K) {
+	if len(records) < 2 {
+		return
+	}
+
+	// keys are measured from the smallest one, which maps signed and
+	// unsigned keys alike to order-preserving unsigned distances
+	lo := key(records[0])
+	for _, r := range records[1:] {
+		lo = min(lo, key(r))
+	}
+
+	distances := make([]uint64, len(records))
+	var spread uint64
+	for i, r := range records {
+		distances[i] = offset(key(r), lo)
+		spread |= distances[i]
+	}
+
+	src, dst := records, make([]R, len(records))
+	srcKeys, dstKeys := distances, make([]uint64, len(records))
+
+	for shift := 0; shift < bits.Len64(spread); shift += 8 {
+		var counts [257]int
+		for _, d := range srcKeys {
+			counts[(d>>shift)&0xff+1]++
+		}
+		for i := 1; i < len(counts); i++ {
+			counts[i] += counts[i-1]
+		}
+
+		for i, d := range srcKeys {
+			digit := (d >> shift) & 0xff
+			dst[counts[digit]] = src[i]
+			dstKeys[counts[digit]] = d
+			counts[digit]++
+		}
+
+		src, dst = dst, src
+		srcKeys, dstKeys = dstKeys, srcKeys
+	}
+
+	if &src[0] != &records[0] {
+		copy(records, src)
+	}
+}
+
+// RadixSortStrings sorts s in place in byte-wise ascending order with an
+// LSD radix sort, from the last character position to the first. Shorter
+// strings sort as if padded with a character below every byte, so a
+// prefix comes before the strings it starts. O(N·L) for strings of up to L
+// bytes.
+func RadixSortStrings(s []string) {
+	if len(s) < 2 {
+		return
+	}
+
+	longest := 0
+	for _, str := range s {
+		longest = max(longest, len(str))
+	}
+
+	src, dst := s, make([]string, len(s))
+	for position := longest - 1; position >= 0; position-- {
+		// bucket 0 holds strings too short to have this position
+		var counts [258]int
+		for _, str := range src {
+			counts[charAt(str, position)+1]++
+		}
+		for i := 1; i < len(counts); i++ {
+			counts[i] += counts[i-1]
+		}
+
+		for _, str := range src {
+			c := charAt(str, position)
+			dst[counts[c]] = str
+			counts[c]++
+		}
+
+		src, dst = dst, src
+	}
+
+	if &src[0] != &s[0] {
+		copy(s, src)
+	}
+}
+
+// charAt returns the byte at position plus one, or 0 past the end.
+func charAt(s string, position int) int {
+	if position >= len(s) {
+		return 0
+	}
+
+	return int(s[position]) + 1
+}
@@ -0,0 +1,37 @@
+package sorting
+
+import (
+	"cmp"
+
+	"github.com/devluxor/common-sense-guide-to-dsa/go_exercises/steps"
+)
+
+// BinarySearch looks for target in the ascending slice sorted and returns
+// its index, or the index where it would be inserted and false. Every step
+// halves the range left to search, so it takes at most log2(N) + 1
+// comparisons.
+func BinarySearch[T cmp.Ordered](sorted []T, target T) (int, bool) {
+	return BinarySearchCounted(sorted, target, nil)
+}
+
+// BinarySearchCounted is BinarySearch reporting every probe to c as one
+// comparison.
+func BinarySearchCounted[T cmp.Ordered](sorted []T, target T, c *steps.Counter) (int, bool) {
+	lowerBound, upperBound := 0, len(sorted)
+
+	for lowerBound < upperBound {
+		midpoint := lowerBound + (upperBound-lowerBound)/2
+
+		c.Compare()
+		switch v := cmp.Compare(target, sorted[midpoint]); {
+		case v < 0:
+			upperBound = midpoint
+		case v > 0:
+			lowerBound = midpoint + 1
+		default:
+			return midpoint, true
+		}
+	}
+
+	return lowerBound, false
+}
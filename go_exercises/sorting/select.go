@@ -0,0 +1,121 @@
+package sorting
+
+import (
+	"cmp"
+	"math"
+	"math/bits"
+	"slices"
+)
+
+// The functions in this file find order statistics without sorting the
+// whole slice. They reorder s in place, partitioning it around the answer,
+// so pass a copy if the original order matters.
+
+// Select returns the k-th smallest item of s, counting from 0, and leaves
+// it at s[k] with smaller or equal items before it and greater or equal
+// ones after it. It reports false if k is out of range. See SelectFunc.
+func Select[T cmp.Ordered](s []T, k int) (T, bool) {
+	return SelectFunc(s, k, cmp.Compare[T])
+}
+
+// SelectFunc is like Select but orders items with compare.
+//
+// It is an introselect: quickselect with the same median-of-three,
+// three-way partitioning as SortFunc, recursing into only the side that
+// holds k, for O(N) on average. If the partitions keep coming out lopsided
+// it switches to median-of-medians pivots, which guarantee O(N) time in
+// the worst case too.
+func SelectFunc[T any](s []T, k int, compare func(a, b T) int) (T, bool) {
+	if k < 0 || k >= len(s) {
+		var zero T
+		return zero, false
+	}
+
+	return quickselect(s, k, compare, 2*bits.Len(uint(len(s)))), true
+}
+
+func quickselect[T any](s []T, k int, compare func(a, b T) int, depth int) T {
+	for len(s) > insertionThreshold {
+		var pivot T
+		if depth > 0 {
+			depth--
+			pivot = medianOfThree(s, compare)
+		} else {
+			pivot = medianOfMedians(s, compare)
+		}
+
+		lt, gt := partition3(s, pivot, compare)
+		switch {
+		case k < lt:
+			s = s[:lt]
+		case k >= gt:
+			s = s[gt:]
+			k -= gt
+		default:
+			return s[k]
+		}
+	}
+
+	insertionSort(s, compare)
+	return s[k]
+}
+
+// medianOfMedians returns a pivot guaranteed to have at least 30% of the
+// items on each side: the median of the medians of groups of five.
+func medianOfMedians[T any](s []T, compare func(a, b T) int) T {
+	medians := make([]T, 0, (len(s)+4)/5)
+	for i := 0; i < len(s); i += 5 {
+		group := s[i:min(i+5, len(s))]
+		insertionSort(group, compare)
+		medians = append(medians, group[len(group)/2])
+	}
+
+	// a depth of 0 keeps the recursion on median-of-medians pivots
+	return quickselect(medians, len(medians)/2, compare, 0)
+}
+
+// Median returns the median of s, or the lower of the two middle items
+// when the length is even. It reports false if s is empty.
+func Median[T cmp.Ordered](s []T) (T, bool) {
+	return Select(s, (len(s)-1)/2)
+}
+
+// Percentile returns the p-th percentile of s, 0 <= p <= 100, by the
+// nearest-rank method: the smallest item that is greater than or equal to
+// p percent of the items. It reports false if s is empty or p is out of
+// range.
+func Percentile[T cmp.Ordered](s []T, p float64) (T, bool) {
+	return PercentileFunc(s, p, cmp.Compare[T])
+}
+
+// PercentileFunc is like Percentile but orders items with compare.
+func PercentileFunc[T any](s []T, p float64, compare func(a, b T) int) (T, bool) {
+	if len(s) == 0 || !(p >= 0 && p <= 100) {
+		var zero T
+		return zero, false
+	}
+
+	rank := int(math.Ceil(p / 100 * float64(len(s))))
+	return SelectFunc(s, max(rank-1, 0), compare)
+}
+
+// TopK returns the k greatest items of s in descending order, or all of
+// them if s is shorter. Only those k items get sorted: O(N + k log k).
+func TopK[T cmp.Ordered](s []T, k int) []T {
+	return TopKFunc(s, k, cmp.Compare[T])
+}
+
+// TopKFunc is like TopK but orders items with compare.
+func TopKFunc[T any](s []T, k int, compare func(a, b T) int) []T {
+	k = min(max(k, 0), len(s))
+	if k == 0 {
+		return []T{}
+	}
+
+	cut := len(s) - k
+	SelectFunc(s, cut, compare)
+
+	top := slices.Clone(s[cut:])
+	SortFunc(top, func(a, b T) int { return compare(b, a) })
+	return top
+}
@@ -0,0 +1,71 @@
+// Package stack provides a slice-backed LIFO stack.
+package stack
+
+import "iter"
+
+// Stack is a last-in, first-out collection. The zero value is an empty
+// stack ready to use.
+type Stack[T any] struct {
+	items []T
+}
+
+// New returns an empty stack.
+func New[T any]() *Stack[T] {
+	return &Stack[T]{}
+}
+
+// Peek returns the top item without removing it.
+func (s *Stack[T]) Peek() (T, bool) {
+	if len(s.items) == 0 {
+		var zero T
+		return zero, false
+	}
+
+	return s.items[len(s.items)-1], true
+}
+
+// Push adds n on top of the stack.
+func (s *Stack[T]) Push(n T) {
+	s.items = append(s.items, n)
+}
+
+// Pop removes and returns the top item.
+func (s *Stack[T]) Pop() (T, bool) {
+	var zero T
+	length := len(s.items)
+	if length == 0 {
+		return zero, false
+	}
+
+	n := s.items[length-1]
+	// clear the slot so the stack does not keep the item alive
+	s.items[length-1] = zero
+	s.items = s.items[:length-1]
+	return n, true
+}
+
+// Len returns the number of items.
+func (s *Stack[T]) Len() int {
+	return len(s.items)
+}
+
+// Empty reports whether the stack holds no items.
+func (s *Stack[T]) Empty() bool {
+	return len(s.items) == 0
+}
+
+// Clear removes every item and releases the backing array.
+func (s *Stack[T]) Clear() {
+	s.items = nil
+}
+
+// All returns an iterator over the items from the top of the stack down.
+func (s *Stack[T]) All() iter.Seq[T] {
+	return func(yield func(T) bool) {
+		for i := len(s.items) - 1; i >= 0; i-- {
+			if !yield(s.items[i]) {
+				return
+			}
+		}
+	}
+}
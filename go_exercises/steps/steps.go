@@ -0,0 +1,88 @@
+// Package steps counts the steps algorithms take, which is how the book
+// measures efficiency before reaching for Big O notation.
+//
+// Instrumented code reports into a *Counter. A nil *Counter is valid and
+// ignores every report, so counting is opt-in and costs only a nil check
+// when it is off.
+package steps
+
+import "fmt"
+
+// Counter tallies steps by kind.
+type Counter struct {
+	Comparisons int
+	Swaps       int
+	Reads       int
+	Writes      int
+	HashLookups int
+}
+
+// Compare records a comparison between two items.
+func (c *Counter) Compare() {
+	if c != nil {
+		c.Comparisons++
+	}
+}
+
+// Swap records two items trading places.
+func (c *Counter) Swap() {
+	if c != nil {
+		c.Swaps++
+	}
+}
+
+// Read records a read, such as visiting a node.
+func (c *Counter) Read() {
+	if c != nil {
+		c.Reads++
+	}
+}
+
+// Write records a write, such as linking in a node.
+func (c *Counter) Write() {
+	if c != nil {
+		c.Writes++
+	}
+}
+
+// HashLookup records a hash table lookup or insertion.
+func (c *Counter) HashLookup() {
+	if c != nil {
+		c.HashLookups++
+	}
+}
+
+// Total returns the number of steps of every kind.
+func (c *Counter) Total() int {
+	if c == nil {
+		return 0
+	}
+
+	return c.Comparisons + c.Swaps + c.Reads + c.Writes + c.HashLookups
+}
+
+// Reset sets every count back to zero.
+func (c *Counter) Reset() {
+	if c != nil {
+		*c = Counter{}
+	}
+}
+
+func (c *Counter) String() string {
+	if c == nil {
+		return "steps: not counting"
+	}
+
+	return fmt.Sprintf("%d steps: %d comparisons, %d swaps, %d reads, %d writes, %d hash lookups",
+		c.Total(), c.Comparisons, c.Swaps, c.Reads, c.Writes, c.HashLookups)
+}
+
+// CountCompare wraps compare so that every call is recorded in c. It
+// instruments any function that takes a comparator, such as
+// sorting.SortFunc.
+func CountCompare[T any](c *Counter, compare func(a, b T) int) func(a, b T) int {
+	return func(a, b T) int {
+		c.Compare()
+		return compare(a, b)
+	}
+}
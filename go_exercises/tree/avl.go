@@ -0,0 +1,166 @@
+package tree
+
+import (
+	"cmp"
+	"fmt"
+)
+
+// AVL is a self-balancing binary search tree: the heights of the two
+// subtrees of every node differ by at most one, so the tree never gets
+// deeper than about 1.44·log2(N) and every operation is O(log N).
+type AVL[K, V any] struct {
+	ordered[K, V]
+}
+
+// NewAVL returns an empty AVL tree ordered by cmp.Compare.
+func NewAVL[K cmp.Ordered, V any]() *AVL[K, V] {
+	return NewAVLFunc[K, V](cmp.Compare[K])
+}
+
+// NewAVLFunc returns an empty AVL tree ordered by compare. See NewBSTFunc.
+func NewAVLFunc[K, V any](compare func(a, b K) int) *AVL[K, V] {
+	return &AVL[K, V]{ordered[K, V]{compare: compare}}
+}
+
+func (n *node[K, V]) getHeight() int {
+	if n == nil {
+		return 0
+	}
+
+	return n.height
+}
+
+func (n *node[K, V]) updateHeight() {
+	n.height = 1 + max(n.leftChild.getHeight(), n.rightChild.getHeight())
+}
+
+func (n *node[K, V]) balanceFactor() int {
+	return n.leftChild.getHeight() - n.rightChild.getHeight()
+}
+
+// rebalance restores the AVL property at n, assuming both subtrees
+// already satisfy it, and returns the new root of the subtree.
+func rebalance[K, V any](n *node[K, V]) *node[K, V] {
+	n.resize()
+	n.updateHeight()
+
+	switch balance := n.balanceFactor(); {
+	case balance > 1:
+		// left-right case: turn it into a left-left case first
+		if n.leftChild.balanceFactor() < 0 {
+			n.leftChild = rotateLeft(n.leftChild)
+		}
+		return rotateRight(n)
+	case balance < -1:
+		// right-left case: turn it into a right-right case first
+		if n.rightChild.balanceFactor() > 0 {
+			n.rightChild = rotateRight(n.rightChild)
+		}
+		return rotateLeft(n)
+	}
+
+	return n
+}
+
+// Put stores value under key, replacing any previous value.
+func (t *AVL[K, V]) Put(key K, value V) {
+	t.root = t.put(t.root, key, value)
+}
+
+func (t *AVL[K, V]) put(n *node[K, V], key K, value V) *node[K, V] {
+	if n == nil {
+		return &node[K, V]{key: key, value: value, size: 1, height: 1}
+	}
+
+	c := t.compareKey(key, n.key)
+	switch {
+	case c < 0:
+		n.leftChild = t.put(n.leftChild, key, value)
+	case c > 0:
+		n.rightChild = t.put(n.rightChild, key, value)
+	default:
+		n.value = value
+		return n
+	}
+
+	return rebalance(n)
+}
+
+// Delete removes key from the tree and reports whether it was present.
+func (t *AVL[K, V]) Delete(key K) bool {
+	var deleted bool
+	t.root = t.delete(t.root, key, &deleted)
+	return deleted
+}
+
+func (t *AVL[K, V]) delete(n *node[K, V], key K, deleted *bool) *node[K, V] {
+	if n == nil {
+		return nil
+	}
+
+	c := t.compareKey(key, n.key)
+	switch {
+	case c < 0:
+		n.leftChild = t.delete(n.leftChild, key, deleted)
+	case c > 0:
+		n.rightChild = t.delete(n.rightChild, key, deleted)
+	default:
+		*deleted = true
+
+		if n.leftChild == nil {
+			return n.rightChild
+		}
+		if n.rightChild == nil {
+			return n.leftChild
+		}
+
+		successor := minNode(n.rightChild)
+		successor.rightChild = avlDeleteMin(n.rightChild)
+		successor.leftChild = n.leftChild
+		n = successor
+	}
+
+	return rebalance(n)
+}
+
+func avlDeleteMin[K, V any](n *node[K, V]) *node[K, V] {
+	if n.leftChild == nil {
+		return n.rightChild
+	}
+
+	n.leftChild = avlDeleteMin(n.leftChild)
+	return rebalance(n)
+}
+
+// Check verifies the AVL invariants: symmetric order, subtree sizes,
+// stored heights, and a balance factor between -1 and 1 at every node.
+func (t *AVL[K, V]) Check() error {
+	if err := t.checkOrder(); err != nil {
+		return err
+	}
+
+	var check func(n *node[K, V]) error
+	check = func(n *node[K, V]) error {
+		if n == nil {
+			return nil
+		}
+
+		if err := check(n.leftChild); err != nil {
+			return err
+		}
+		if err := check(n.rightChild); err != nil {
+			return err
+		}
+
+		if n.height != 1+max(n.leftChild.getHeight(), n.rightChild.getHeight()) {
+			return fmt.Errorf("tree: wrong height %d at key %v", n.height, n.key)
+		}
+		if b := n.balanceFactor(); b < -1 || b > 1 {
+			return fmt.Errorf("tree: balance factor %d at key %v", b, n.key)
+		}
+
+		return nil
+	}
+
+	return check(t.root)
+}
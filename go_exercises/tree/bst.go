@@ -0,0 +1,103 @@
+package tree
+
+import "cmp"
+
+// BST is an unbalanced binary search tree used as an ordered map. Every
+// operation costs O(log N) on a well-balanced tree and O(N) in the worst
+// case, when keys arrive already sorted.
+type BST[K, V any] struct {
+	ordered[K, V]
+}
+
+// NewBST returns an empty tree ordered by cmp.Compare.
+func NewBST[K cmp.Ordered, V any]() *BST[K, V] {
+	return NewBSTFunc[K, V](cmp.Compare[K])
+}
+
+// NewBSTFunc returns an empty tree ordered by compare, which must return a
+// negative number when a < b, zero when a == b and a positive number when
+// a > b.
+func NewBSTFunc[K, V any](compare func(a, b K) int) *BST[K, V] {
+	return &BST[K, V]{ordered[K, V]{compare: compare}}
+}
+
+// Put stores value under key, replacing any previous value.
+func (t *BST[K, V]) Put(key K, value V) {
+	t.root = t.put(t.root, key, value)
+}
+
+func (t *BST[K, V]) put(n *node[K, V], key K, value V) *node[K, V] {
+	if n == nil {
+		return &node[K, V]{key: key, value: value, size: 1}
+	}
+
+	c := t.compareKey(key, n.key)
+	switch {
+	case c < 0:
+		n.leftChild = t.put(n.leftChild, key, value)
+	case c > 0:
+		n.rightChild = t.put(n.rightChild, key, value)
+	default:
+		n.value = value
+	}
+
+	n.resize()
+	return n
+}
+
+// Delete removes key from the tree and reports whether it was present.
+func (t *BST[K, V]) Delete(key K) bool {
+	var deleted bool
+	t.root = t.delete(t.root, key, &deleted)
+	return deleted
+}
+
+func (t *BST[K, V]) delete(n *node[K, V], key K, deleted *bool) *node[K, V] {
+	if n == nil {
+		return nil
+	}
+
+	c := t.compareKey(key, n.key)
+	switch {
+	case c < 0:
+		n.leftChild = t.delete(n.leftChild, key, deleted)
+	case c > 0:
+		n.rightChild = t.delete(n.rightChild, key, deleted)
+	default:
+		*deleted = true
+
+		// zero or one child: the child takes the node's place
+		if n.leftChild == nil {
+			return n.rightChild
+		}
+		if n.rightChild == nil {
+			return n.leftChild
+		}
+
+		// two children: the successor (the smallest key of the right
+		// subtree) takes the node's place
+		successor := minNode(n.rightChild)
+		successor.rightChild = deleteMin(n.rightChild)
+		successor.leftChild = n.leftChild
+		n = successor
+	}
+
+	n.resize()
+	return n
+}
+
+func deleteMin[K, V any](n *node[K, V]) *node[K, V] {
+	if n.leftChild == nil {
+		return n.rightChild
+	}
+
+	n.leftChild = deleteMin(n.leftChild)
+	n.resize()
+	return n
+}
+
+// Check verifies the binary search tree invariants: keys are in symmetric
+// order and subtree sizes are consistent.
+func (t *BST[K, V]) Check() error {
+	return t.checkOrder()
+}
@@ -0,0 +1,148 @@
+package tree
+
+import "iter"
+
+// The walkers below are shared by Node and the ordered maps. Each returns
+// false as soon as yield does, so callers can stop a traversal early.
+
+func walkInOrder[N any](n *N, left, right func(*N) *N, yield func(*N) bool) bool {
+	if n == nil {
+		return true
+	}
+
+	return walkInOrder(left(n), left, right, yield) &&
+		yield(n) &&
+		walkInOrder(right(n), left, right, yield)
+}
+
+func walkPreOrder[N any](n *N, left, right func(*N) *N, yield func(*N) bool) bool {
+	if n == nil {
+		return true
+	}
+
+	return yield(n) &&
+		walkPreOrder(left(n), left, right, yield) &&
+		walkPreOrder(right(n), left, right, yield)
+}
+
+func walkPostOrder[N any](n *N, left, right func(*N) *N, yield func(*N) bool) bool {
+	if n == nil {
+		return true
+	}
+
+	return walkPostOrder(left(n), left, right, yield) &&
+		walkPostOrder(right(n), left, right, yield) &&
+		yield(n)
+}
+
+// walkLevelOrder visits the tree breadth-first, using a slice as a queue.
+func walkLevelOrder[N any](n *N, left, right func(*N) *N, yield func(*N) bool) bool {
+	if n == nil {
+		return true
+	}
+
+	queue := []*N{n}
+	for len(queue) > 0 {
+		current := queue[0]
+		queue = queue[1:]
+
+		if !yield(current) {
+			return false
+		}
+
+		if l := left(current); l != nil {
+			queue = append(queue, l)
+		}
+		if r := right(current); r != nil {
+			queue = append(queue, r)
+		}
+	}
+
+	return true
+}
+
+type walker[N any] func(n *N, left, right func(*N) *N, yield func(*N) bool) bool
+
+// All returns an iterator over the values of the tree in ascending order.
+func (node *Node[T]) All() iter.Seq[T] {
+	return node.InOrder()
+}
+
+// InOrder returns an iterator over the values in ascending order.
+func (node *Node[T]) InOrder() iter.Seq[T] {
+	return node.values(walkInOrder)
+}
+
+// PreOrder returns an iterator that visits every node before its children.
+func (node *Node[T]) PreOrder() iter.Seq[T] {
+	return node.values(walkPreOrder)
+}
+
+// PostOrder returns an iterator that visits every node after its children.
+func (node *Node[T]) PostOrder() iter.Seq[T] {
+	return node.values(walkPostOrder)
+}
+
+// LevelOrder returns an iterator that visits the tree level by level, from
+// the root down and from left to right.
+func (node *Node[T]) LevelOrder() iter.Seq[T] {
+	return node.values(walkLevelOrder)
+}
+
+func (node *Node[T]) values(walk walker[Node[T]]) iter.Seq[T] {
+	return func(yield func(T) bool) {
+		walk(node, (*Node[T]).Left, (*Node[T]).Right, func(n *Node[T]) bool {
+			return yield(n.Data)
+		})
+	}
+}
+
+func (n *node[K, V]) left() *node[K, V] {
+	return n.leftChild
+}
+
+func (n *node[K, V]) right() *node[K, V] {
+	return n.rightChild
+}
+
+// All returns an iterator over the key-value pairs in ascending key order.
+func (t *ordered[K, V]) All() iter.Seq2[K, V] {
+	return t.pairs(walkInOrder, false)
+}
+
+// Backward returns an iterator over the key-value pairs in descending key
+// order.
+func (t *ordered[K, V]) Backward() iter.Seq2[K, V] {
+	return t.pairs(walkInOrder, true)
+}
+
+// PreOrder returns an iterator that visits every node before its children.
+func (t *ordered[K, V]) PreOrder() iter.Seq2[K, V] {
+	return t.pairs(walkPreOrder, false)
+}
+
+// PostOrder returns an iterator that visits every node after its children.
+func (t *ordered[K, V]) PostOrder() iter.Seq2[K, V] {
+	return t.pairs(walkPostOrder, false)
+}
+
+// LevelOrder returns an iterator that visits the tree level by level, from
+// the root down and from left to right.
+func (t *ordered[K, V]) LevelOrder() iter.Seq2[K, V] {
+	return t.pairs(walkLevelOrder, false)
+}
+
+// pairs adapts a walker to an iter.Seq2. The tree is read when iteration
+// starts, so the iterator reflects later changes to the map.
+func (t *ordered[K, V]) pairs(walk walker[node[K, V]], mirrored bool) iter.Seq2[K, V] {
+	left, right := (*node[K, V]).left, (*node[K, V]).right
+	if mirrored {
+		left, right = right, left
+	}
+
+	return func(yield func(K, V) bool) {
+		walk(t.root, left, right, func(n *node[K, V]) bool {
+			return yield(n.key, n.value)
+		})
+	}
+}
@@ -0,0 +1,271 @@
+package tree
+
+import (
+	"errors"
+	"fmt"
+	"iter"
+
+	"github.com/devluxor/common-sense-guide-to-dsa/go_exercises/steps"
+)
+
+// OrderedMap is the ordered-map API shared by BST, AVL and RedBlack.
+type OrderedMap[K, V any] interface {
+	Len() int
+	Get(key K) (V, bool)
+	Put(key K, value V)
+	Delete(key K) bool
+	Min() (K, bool)
+	Max() (K, bool)
+	Floor(key K) (K, bool)
+	Ceiling(key K) (K, bool)
+	Rank(key K) int
+	Select(i int) (K, bool)
+	All() iter.Seq2[K, V]
+	Backward() iter.Seq2[K, V]
+}
+
+var (
+	_ OrderedMap[int, int] = (*BST[int, int])(nil)
+	_ OrderedMap[int, int] = (*AVL[int, int])(nil)
+	_ OrderedMap[int, int] = (*RedBlack[int, int])(nil)
+)
+
+// node is shared by every ordered map. height is only maintained by AVL
+// and red only by RedBlack.
+type node[K, V any] struct {
+	key        K
+	value      V
+	size       int
+	height     int
+	red        bool
+	leftChild  *node[K, V]
+	rightChild *node[K, V]
+}
+
+func (n *node[K, V]) len() int {
+	if n == nil {
+		return 0
+	}
+
+	return n.size
+}
+
+func (n *node[K, V]) resize() {
+	n.size = 1 + n.leftChild.len() + n.rightChild.len()
+}
+
+// ordered holds the read-only operations, which work the same way whatever
+// strategy keeps the tree balanced.
+type ordered[K, V any] struct {
+	root    *node[K, V]
+	compare func(a, b K) int
+	counter *steps.Counter
+}
+
+// SetCounter makes every key comparison from now on be reported to c. A
+// nil c stops counting.
+func (t *ordered[K, V]) SetCounter(c *steps.Counter) {
+	t.counter = c
+}
+
+func (t *ordered[K, V]) compareKey(key, other K) int {
+	t.counter.Compare()
+	return t.compare(key, other)
+}
+
+// Len returns the number of keys in the tree.
+func (t *ordered[K, V]) Len() int {
+	return t.root.len()
+}
+
+// Get returns the value stored under key.
+func (t *ordered[K, V]) Get(key K) (V, bool) {
+	node := t.root
+	for node != nil {
+		c := t.compareKey(key, node.key)
+		switch {
+		case c < 0:
+			node = node.leftChild
+		case c > 0:
+			node = node.rightChild
+		default:
+			return node.value, true
+		}
+	}
+
+	var zero V
+	return zero, false
+}
+
+// Min returns the smallest key.
+func (t *ordered[K, V]) Min() (K, bool) {
+	if t.root == nil {
+		var zero K
+		return zero, false
+	}
+
+	return minNode(t.root).key, true
+}
+
+func minNode[K, V any](node *node[K, V]) *node[K, V] {
+	for node.leftChild != nil {
+		node = node.leftChild
+	}
+
+	return node
+}
+
+// Max returns the greatest key.
+func (t *ordered[K, V]) Max() (K, bool) {
+	if t.root == nil {
+		var zero K
+		return zero, false
+	}
+
+	node := t.root
+	for node.rightChild != nil {
+		node = node.rightChild
+	}
+
+	return node.key, true
+}
+
+// Floor returns the greatest key less than or equal to key.
+func (t *ordered[K, V]) Floor(key K) (K, bool) {
+	var floor K
+	found := false
+
+	node := t.root
+	for node != nil {
+		c := t.compareKey(key, node.key)
+		switch {
+		case c < 0:
+			node = node.leftChild
+		case c > 0:
+			floor, found = node.key, true
+			node = node.rightChild
+		default:
+			return node.key, true
+		}
+	}
+
+	return floor, found
+}
+
+// Ceiling returns the smallest key greater than or equal to key.
+func (t *ordered[K, V]) Ceiling(key K) (K, bool) {
+	var ceiling K
+	found := false
+
+	node := t.root
+	for node != nil {
+		c := t.compareKey(key, node.key)
+		switch {
+		case c < 0:
+			ceiling, found = node.key, true
+			node = node.leftChild
+		case c > 0:
+			node = node.rightChild
+		default:
+			return node.key, true
+		}
+	}
+
+	return ceiling, found
+}
+
+// Rank returns the number of keys strictly less than key.
+func (t *ordered[K, V]) Rank(key K) int {
+	rank := 0
+
+	node := t.root
+	for node != nil {
+		c := t.compareKey(key, node.key)
+		switch {
+		case c < 0:
+			node = node.leftChild
+		case c > 0:
+			rank += 1 + node.leftChild.len()
+			node = node.rightChild
+		default:
+			return rank + node.leftChild.len()
+		}
+	}
+
+	return rank
+}
+
+// Select returns the key of rank i, that is, the (i+1)th smallest key.
+func (t *ordered[K, V]) Select(i int) (K, bool) {
+	if i < 0 || i >= t.Len() {
+		var zero K
+		return zero, false
+	}
+
+	node := t.root
+	for {
+		leftSize := node.leftChild.len()
+		switch {
+		case i < leftSize:
+			node = node.leftChild
+		case i > leftSize:
+			i -= leftSize + 1
+			node = node.rightChild
+		default:
+			return node.key, true
+		}
+	}
+}
+
+// checkOrder verifies that keys are in symmetric order and that every
+// subtree size is correct.
+func (t *ordered[K, V]) checkOrder() error {
+	var check func(n *node[K, V], low, high *K) error
+	check = func(n *node[K, V], low, high *K) error {
+		if n == nil {
+			return nil
+		}
+
+		if low != nil && t.compare(n.key, *low) <= 0 {
+			return fmt.Errorf("tree: key %v is not greater than %v", n.key, *low)
+		}
+		if high != nil && t.compare(n.key, *high) >= 0 {
+			return fmt.Errorf("tree: key %v is not less than %v", n.key, *high)
+		}
+		if n.size != 1+n.leftChild.len()+n.rightChild.len() {
+			return fmt.Errorf("tree: wrong subtree size %d at key %v", n.size, n.key)
+		}
+
+		return errors.Join(check(n.leftChild, low, &n.key), check(n.rightChild, &n.key, high))
+	}
+
+	return check(t.root, nil, nil)
+}
+
+// rotateLeft makes the right child of n the root of the subtree and
+// returns it. Sizes and heights are updated; colors are left to the caller.
+func rotateLeft[K, V any](n *node[K, V]) *node[K, V] {
+	x := n.rightChild
+	n.rightChild = x.leftChild
+	x.leftChild = n
+
+	n.resize()
+	n.updateHeight()
+	x.resize()
+	x.updateHeight()
+	return x
+}
+
+// rotateRight makes the left child of n the root of the subtree and
+// returns it. Sizes and heights are updated; colors are left to the caller.
+func rotateRight[K, V any](n *node[K, V]) *node[K, V] {
+	x := n.leftChild
+	n.leftChild = x.rightChild
+	x.rightChild = n
+
+	n.resize()
+	n.updateHeight()
+	x.resize()
+	x.updateHeight()
+	return x
+}
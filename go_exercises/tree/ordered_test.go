@@ -0,0 +1,84 @@
+package tree
+
+import (
+	"math/rand/v2"
+	"slices"
+	"testing"
+)
+
+// checked is an ordered map whose invariants can be verified.
+type checked interface {
+	OrderedMap[int, int]
+	Check() error
+}
+
+func TestRandomOperationsKeepInvariants(t *testing.T) {
+	maps := map[string]func() checked{
+		"BST":      func() checked { return NewBST[int, int]() },
+		"AVL":      func() checked { return NewAVL[int, int]() },
+		"RedBlack": func() checked { return NewRedBlack[int, int]() },
+	}
+
+	for name, newMap := range maps {
+		t.Run(name, func(t *testing.T) {
+			r := rand.New(rand.NewPCG(3, 4))
+			m := newMap()
+			want := map[int]int{}
+
+			for i := range 3000 {
+				k := r.IntN(200)
+				if r.IntN(3) == 0 {
+					_, ok := want[k]
+					if got := m.Delete(k); got != ok {
+						t.Fatalf("step %d: Delete(%d) = %v, want %v", i, k, got, ok)
+					}
+					delete(want, k)
+				} else {
+					m.Put(k, i)
+					want[k] = i
+				}
+
+				if err := m.Check(); err != nil {
+					t.Fatalf("step %d: %v", i, err)
+				}
+				if m.Len() != len(want) {
+					t.Fatalf("step %d: Len() = %d, want %d", i, m.Len(), len(want))
+				}
+			}
+
+			keys := []int{}
+			for k, v := range m.All() {
+				if want[k] != v {
+					t.Fatalf("All yielded %d: %d, want %d", k, v, want[k])
+				}
+				keys = append(keys, k)
+			}
+			if !slices.IsSorted(keys) || len(keys) != len(want) {
+				t.Fatalf("All yielded keys %v, want the %d keys in order", keys, len(want))
+			}
+
+			for i, k := range keys {
+				if got := m.Rank(k); got != i {
+					t.Fatalf("Rank(%d) = %d, want %d", k, got, i)
+				}
+				if got, _ := m.Select(i); got != k {
+					t.Fatalf("Select(%d) = %d, want %d", i, got, k)
+				}
+			}
+
+			// drain the map in random order, checking after every delete
+			r.Shuffle(len(keys), func(i, j int) { keys[i], keys[j] = keys[j], keys[i] })
+			for _, k := range keys {
+				if !m.Delete(k) {
+					t.Fatalf("Delete(%d) = false for a present key", k)
+				}
+				if err := m.Check(); err != nil {
+					t.Fatalf("after Delete(%d): %v", k, err)
+				}
+			}
+			if m.Len() != 0 {
+				t.Fatalf("Len() = %d after deleting every key", m.Len())
+			}
+		})
+	}
+}
@@ -0,0 +1,217 @@
+package tree
+
+import (
+	"cmp"
+	"errors"
+	"fmt"
+)
+
+// RedBlack is a left-leaning red-black tree (Sedgewick's LLRB). It mirrors
+// a 2-3 tree: a red link glues a node to its parent to form a 3-node. Every
+// path from the root to a leaf crosses the same number of black links, so
+// the height stays below 2·log2(N) and every operation is O(log N).
+type RedBlack[K, V any] struct {
+	ordered[K, V]
+}
+
+// NewRedBlack returns an empty red-black tree ordered by cmp.Compare.
+func NewRedBlack[K cmp.Ordered, V any]() *RedBlack[K, V] {
+	return NewRedBlackFunc[K, V](cmp.Compare[K])
+}
+
+// NewRedBlackFunc returns an empty red-black tree ordered by compare. See
+// NewBSTFunc.
+func NewRedBlackFunc[K, V any](compare func(a, b K) int) *RedBlack[K, V] {
+	return &RedBlack[K, V]{ordered[K, V]{compare: compare}}
+}
+
+func isRed[K, V any](n *node[K, V]) bool {
+	return n != nil && n.red
+}
+
+func redRotateLeft[K, V any](n *node[K, V]) *node[K, V] {
+	x := rotateLeft(n)
+	x.red = n.red
+	n.red = true
+	return x
+}
+
+func redRotateRight[K, V any](n *node[K, V]) *node[K, V] {
+	x := rotateRight(n)
+	x.red = n.red
+	n.red = true
+	return x
+}
+
+func flipColors[K, V any](n *node[K, V]) {
+	n.red = !n.red
+	n.leftChild.red = !n.leftChild.red
+	n.rightChild.red = !n.rightChild.red
+}
+
+// fixUp restores the left-leaning invariants on the way back up.
+func fixUp[K, V any](n *node[K, V]) *node[K, V] {
+	if isRed(n.rightChild) && !isRed(n.leftChild) {
+		n = redRotateLeft(n)
+	}
+	if isRed(n.leftChild) && isRed(n.leftChild.leftChild) {
+		n = redRotateRight(n)
+	}
+	if isRed(n.leftChild) && isRed(n.rightChild) {
+		flipColors(n)
+	}
+
+	n.resize()
+	return n
+}
+
+// moveRedLeft makes n.leftChild or one of its children red, assuming n is
+// red and both its children are black.
+func moveRedLeft[K, V any](n *node[K, V]) *node[K, V] {
+	flipColors(n)
+	if isRed(n.rightChild.leftChild) {
+		n.rightChild = redRotateRight(n.rightChild)
+		n = redRotateLeft(n)
+		flipColors(n)
+	}
+
+	return n
+}
+
+// moveRedRight makes n.rightChild or one of its children red, assuming n
+// is red and both n.rightChild and n.rightChild.leftChild are black.
+func moveRedRight[K, V any](n *node[K, V]) *node[K, V] {
+	flipColors(n)
+	if isRed(n.leftChild.leftChild) {
+		n = redRotateRight(n)
+		flipColors(n)
+	}
+
+	return n
+}
+
+// Put stores value under key, replacing any previous value.
+func (t *RedBlack[K, V]) Put(key K, value V) {
+	t.root = t.put(t.root, key, value)
+	t.root.red = false
+}
+
+func (t *RedBlack[K, V]) put(n *node[K, V], key K, value V) *node[K, V] {
+	if n == nil {
+		return &node[K, V]{key: key, value: value, size: 1, red: true}
+	}
+
+	c := t.compareKey(key, n.key)
+	switch {
+	case c < 0:
+		n.leftChild = t.put(n.leftChild, key, value)
+	case c > 0:
+		n.rightChild = t.put(n.rightChild, key, value)
+	default:
+		n.value = value
+	}
+
+	return fixUp(n)
+}
+
+// Delete removes key from the tree and reports whether it was present.
+func (t *RedBlack[K, V]) Delete(key K) bool {
+	if _, ok := t.Get(key); !ok {
+		return false
+	}
+
+	if !isRed(t.root.leftChild) && !isRed(t.root.rightChild) {
+		t.root.red = true
+	}
+
+	t.root = t.delete(t.root, key)
+	if t.root != nil {
+		t.root.red = false
+	}
+
+	return true
+}
+
+// delete removes key, which must be present in the subtree rooted at n.
+func (t *RedBlack[K, V]) delete(n *node[K, V], key K) *node[K, V] {
+	if t.compareKey(key, n.key) < 0 {
+		if !isRed(n.leftChild) && !isRed(n.leftChild.leftChild) {
+			n = moveRedLeft(n)
+		}
+		n.leftChild = t.delete(n.leftChild, key)
+	} else {
+		if isRed(n.leftChild) {
+			n = redRotateRight(n)
+		}
+		if t.compareKey(key, n.key) == 0 && n.rightChild == nil {
+			return nil
+		}
+		if !isRed(n.rightChild) && !isRed(n.rightChild.leftChild) {
+			n = moveRedRight(n)
+		}
+		if t.compareKey(key, n.key) == 0 {
+			successor := minNode(n.rightChild)
+			n.key, n.value = successor.key, successor.value
+			n.rightChild = redDeleteMin(n.rightChild)
+		} else {
+			n.rightChild = t.delete(n.rightChild, key)
+		}
+	}
+
+	return fixUp(n)
+}
+
+func redDeleteMin[K, V any](n *node[K, V]) *node[K, V] {
+	if n.leftChild == nil {
+		return nil
+	}
+
+	if !isRed(n.leftChild) && !isRed(n.leftChild.leftChild) {
+		n = moveRedLeft(n)
+	}
+	n.leftChild = redDeleteMin(n.leftChild)
+
+	return fixUp(n)
+}
+
+// Check verifies the red-black invariants: symmetric order, subtree sizes,
+// a black root, no right-leaning red links, no two red links in a row, and
+// the same number of black links on every root-to-leaf path.
+func (t *RedBlack[K, V]) Check() error {
+	if err := t.checkOrder(); err != nil {
+		return err
+	}
+	if isRed(t.root) {
+		return errors.New("tree: red root")
+	}
+
+	blackHeight := -1
+	var check func(n *node[K, V], blacks int) error
+	check = func(n *node[K, V], blacks int) error {
+		if n == nil {
+			if blackHeight == -1 {
+				blackHeight = blacks
+			} else if blacks != blackHeight {
+				return fmt.Errorf("tree: black height %d, want %d", blacks, blackHeight)
+			}
+			return nil
+		}
+
+		if isRed(n.rightChild) {
+			return fmt.Errorf("tree: right-leaning red link at key %v", n.key)
+		}
+		if isRed(n) && isRed(n.leftChild) {
+			return fmt.Errorf("tree: two red links in a row at key %v", n.key)
+		}
+		if !isRed(n) {
+			blacks++
+		}
+
+		if err := check(n.leftChild, blacks); err != nil {
+			return err
+		}
+		return check(n.rightChild, blacks)
+	}
+
+	return check(t.root, 0)
+}
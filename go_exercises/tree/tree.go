@@ -0,0 +1,92 @@
+// Package tree provides binary search trees.
+package tree
+
+import (
+	"cmp"
+
+	"github.com/devluxor/common-sense-guide-to-dsa/go_exercises/steps"
+)
+
+// Node is a binary search tree node. Values smaller than Data live in the
+// left subtree and greater ones in the right subtree; duplicates are ignored.
+type Node[T cmp.Ordered] struct {
+	Data       T
+	leftChild  *Node[T]
+	rightChild *Node[T]
+}
+
+// NewNode returns a single-node tree holding d.
+func NewNode[T cmp.Ordered](d T) *Node[T] {
+	return &Node[T]{Data: d}
+}
+
+// Left returns the left child, or nil.
+func (node *Node[T]) Left() *Node[T] {
+	return node.leftChild
+}
+
+// Right returns the right child, or nil.
+func (node *Node[T]) Right() *Node[T] {
+	return node.rightChild
+}
+
+// Search returns the node holding d, or nil if there is none.
+func (node *Node[T]) Search(d T) *Node[T] {
+	return node.SearchCounted(d, nil)
+}
+
+// SearchCounted is Search reporting one comparison to c for every node it
+// inspects.
+func (node *Node[T]) SearchCounted(d T, c *steps.Counter) *Node[T] {
+	if node == nil {
+		return node
+	}
+
+	c.Compare()
+	if node.Data == d {
+		return node
+	}
+
+	if d < node.Data {
+		return node.leftChild.SearchCounted(d, c)
+	} else {
+		return node.rightChild.SearchCounted(d, c)
+	}
+}
+
+// Insert adds d to the tree rooted at node.
+func (node *Node[T]) Insert(d T) {
+	node.InsertCounted(d, nil)
+}
+
+// InsertCounted is Insert reporting one comparison to c for every node it
+// inspects and a write for the new node.
+func (node *Node[T]) InsertCounted(d T, c *steps.Counter) {
+	c.Compare()
+	if d < node.Data {
+		if node.leftChild == nil {
+			node.leftChild = &Node[T]{Data: d}
+			c.Write()
+			return
+		}
+
+		node.leftChild.InsertCounted(d, c)
+	} else if d > node.Data {
+		if node.rightChild == nil {
+			node.rightChild = &Node[T]{Data: d}
+			c.Write()
+			return
+		}
+
+		node.rightChild.InsertCounted(d, c)
+	}
+}
+
+// Greatest returns the node holding the largest value.
+func (currentNode *Node[T]) Greatest() *Node[T] {
+	if currentNode.rightChild == nil {
+		return currentNode
+	}
+
+	return currentNode.rightChild.Greatest()
+}
@@ -0,0 +1,245 @@
+// Package walker flattens arbitrarily nested slices, arrays and maps, such
+// as the values encoding/json decodes into an any, into a lazy sequence of
+// their leaves.
+package walker
+
+import (
+	"cmp"
+	"fmt"
+	"iter"
+	"maps"
+	"reflect"
+	"slices"
+	"strings"
+
+	"github.com/devluxor/common-sense-guide-to-dsa/go_exercises/stack"
+)
+
+// Path locates a leaf from the root: an int for every slice or array index
+// and the key itself for every map entry along the way. Every leaf gets its
+// own copy, so paths may be kept.
+type Path []any
+
+// String renders p like an index expression, such as [3][1]["name"].
+func (p Path) String() string {
+	var b strings.Builder
+	for _, step := range p {
+		if s, ok := step.(string); ok {
+			fmt.Fprintf(&b, "[%q]", s)
+		} else {
+			fmt.Fprintf(&b, "[%v]", step)
+		}
+	}
+
+	return b.String()
+}
+
+// Leaf is a value that Walk does not descend into, and where it was found.
+type Leaf struct {
+	Path  Path
+	Value any
+}
+
+// Options configures Walk.
+type Options struct {
+	// MaxDepth, when positive, stops the walk from descending into
+	// containers MaxDepth levels below the root; they are yielded as
+	// leaves instead, so nothing is skipped.
+	MaxDepth int
+	// Iterative walks with an explicit stack on the heap instead of
+	// recursion, so that very deep nesting cannot grow the goroutine stack
+	// without bound.
+	Iterative bool
+}
+
+// entry is a child of a container with the step that leads to it.
+type entry struct {
+	step  any
+	value any
+}
+
+// expand returns the children of v in order and whether v is a container
+// at all. Slices and arrays yield their elements by index, maps their
+// entries by ascending key, so that walks are deterministic. Strings, byte
+// slices, pointers, structs and nil are leaves.
+func expand(v any) ([]entry, bool) {
+	// the shapes encoding/json produces do not need reflection
+	switch c := v.(type) {
+	case []any:
+		entries := make([]entry, len(c))
+		for i, e := range c {
+			entries[i] = entry{i, e}
+		}
+		return entries, true
+	case map[string]any:
+		entries := make([]entry, 0, len(c))
+		for _, k := range slices.Sorted(maps.Keys(c)) {
+			entries = append(entries, entry{k, c[k]})
+		}
+		return entries, true
+	case []byte:
+		return nil, false
+	}
+
+	rv := reflect.ValueOf(v)
+	switch rv.Kind() {
+	case reflect.Slice, reflect.Array:
+		entries := make([]entry, rv.Len())
+		for i := range entries {
+			entries[i] = entry{i, rv.Index(i).Interface()}
+		}
+		return entries, true
+	case reflect.Map:
+		// take keys and values together: a NaN key cannot be looked up
+		type pair struct{ key, value reflect.Value }
+		pairs := make([]pair, 0, rv.Len())
+		for it := rv.MapRange(); it.Next(); {
+			pairs = append(pairs, pair{it.Key(), it.Value()})
+		}
+		slices.SortFunc(pairs, func(a, b pair) int { return compareKeys(a.key, b.key) })
+
+		entries := make([]entry, len(pairs))
+		for i, p := range pairs {
+			entries[i] = entry{p.key.Interface(), p.value.Interface()}
+		}
+		return entries, true
+	}
+
+	return nil, false
+}
+
+// compareKeys orders map keys of the same kind by value, and falls back to
+// comparing their fmt representations for keys that are not numbers or
+// strings.
+func compareKeys(a, b reflect.Value) int {
+	switch {
+	case a.CanInt():
+		return cmp.Compare(a.Int(), b.Int())
+	case a.CanUint():
+		return cmp.Compare(a.Uint(), b.Uint())
+	case a.CanFloat():
+		return cmp.Compare(a.Float(), b.Float())
+	case a.Kind() == reflect.String:
+		return cmp.Compare(a.String(), b.String())
+	}
+
+	return cmp.Compare(fmt.Sprint(a.Interface()), fmt.Sprint(b.Interface()))
+}
+
+// Walk returns an iterator over the leaves of root in depth-first order:
+// every element of a slice or array by index, every entry of a map by
+// ascending key. A root that is not a container is its own single leaf,
+// with an empty path. Containers that hold themselves make the walk
+// endless.
+func Walk(root any, opts Options) iter.Seq[Leaf] {
+	if opts.Iterative {
+		return walkIterative(root, opts)
+	}
+
+	return func(yield func(Leaf) bool) {
+		path := Path{}
+		walkRecursive(&path, root, opts, yield)
+	}
+}
+
+// isLeaf reports whether the walk stops at a value found at path, and
+// otherwise returns the value's children.
+func isLeaf(path Path, v any, opts Options) ([]entry, bool) {
+	entries, ok := expand(v)
+	return entries, !ok || (opts.MaxDepth > 0 && len(path) >= opts.MaxDepth)
+}
+
+// walkRecursive yields the leaves under v and reports whether the caller
+// should carry on. The path is one buffer, extended on the way down and
+// trimmed on the way back up, and only copied for the leaves, so a chain
+// of D nested containers costs O(D) rather than O(D²).
+func walkRecursive(path *Path, v any, opts Options, yield func(Leaf) bool) bool {
+	entries, leaf := isLeaf(*path, v, opts)
+	if leaf {
+		return yield(Leaf{Path: slices.Clone(*path), Value: v})
+	}
+
+	for _, e := range entries {
+		*path = append(*path, e.step)
+		more := walkRecursive(path, e.value, opts, yield)
+		*path = (*path)[:len(*path)-1]
+		if !more {
+			return false
+		}
+	}
+
+	return true
+}
+
+// frame is a container being walked and how far the walk has got in it.
+type frame struct {
+	entries []entry
+	next    int
+}
+
+// walkIterative is walkRecursive with the frames on a stack.Stack. The
+// path buffer holds one step per frame but the root's.
+func walkIterative(root any, opts Options) iter.Seq[Leaf] {
+	return func(yield func(Leaf) bool) {
+		path := Path{}
+		entries, leaf := isLeaf(path, root, opts)
+		if leaf {
+			yield(Leaf{Path: path, Value: root})
+			return
+		}
+
+		frames := stack.New[*frame]()
+		frames.Push(&frame{entries: entries})
+		for {
+			top, ok := frames.Peek()
+			if !ok {
+				return
+			}
+			if top.next == len(top.entries) {
+				frames.Pop()
+				if frames.Len() > 0 {
+					path = path[:len(path)-1]
+				}
+				continue
+			}
+
+			e := top.entries[top.next]
+			top.next++
+			path = append(path, e.step)
+
+			children, leaf := isLeaf(path, e.value, opts)
+			if leaf {
+				if !yield(Leaf{Path: slices.Clone(path), Value: e.value}) {
+					return
+				}
+				path = path[:len(path)-1]
+				continue
+			}
+			frames.Push(&frame{entries: children})
+		}
+	}
+}
+
+// Of returns an iterator over the leaves of root whose value is a T, with
+// their paths.
+func Of[T any](root any, opts Options) iter.Seq2[Path, T] {
+	return func(yield func(Path, T) bool) {
+		for leaf := range Walk(root, opts) {
+			if v, ok := leaf.Value.(T); ok {
+				if !yield(leaf.Path, v) {
+					return
+				}
+			}
+		}
+	}
+}
+
+// Flatten returns the values of every leaf of root in walk order.
+func Flatten(root any, opts Options) []any {
+	values := []any{}
+	for leaf := range Walk(root, opts) {
+		values = append(values, leaf.Value)
+	}
+
+	return values
+}
@@ -0,0 +1,29 @@
+package walker
+
+import (
+	"math"
+	"reflect"
+	"testing"
+)
+
+func TestFlatten(t *testing.T) {
+	tests := []struct {
+		name string
+		root any
+		want []any
+	}{
+		{"leaf", 7, []any{7}},
+		{"nested", []any{1, []any{2, 3}, map[string]any{"b": 5, "a": 4}}, []any{1, 2, 3, 4, 5}},
+		{"typed map", map[int][]string{2: {"c"}, 1: {"a", "b"}}, []any{"a", "b", "c"}},
+		{"nan key", map[float64]string{math.NaN(): "x", 1: "y"}, []any{"x", "y"}},
+	}
+
+	for _, test := range tests {
+		for _, iterative := range []bool{false, true} {
+			got := Flatten(test.root, Options{Iterative: iterative})
+			if !reflect.DeepEqual(got, test.want) {
+				t.Errorf("%s: Flatten(iterative %v) = %v, want %v", test.name, iterative, got, test.want)
+			}
+		}
+	}
+}